@@ -0,0 +1,144 @@
+package yahoofinance
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Adjustment events prices are adjusted for
+type Adjustment int
+
+// Adjustment
+const (
+	AdjustSplits Adjustment = 1 << iota
+	AdjustDividends
+	// RawPrices declares prices as traded, otherwise they are taken as
+	// split-adjusted like Yahoo's chart prices and their splits are undone first
+	RawPrices
+	AdjustAll = AdjustSplits | AdjustDividends
+)
+
+// AdjustMode direction of adjustment
+type AdjustMode int
+
+// AdjustMode
+const (
+	// BackAdjust keeps the latest bars and scales the earlier ones, like Yahoo's adjusted close
+	BackAdjust AdjustMode = iota
+	// ForwardAdjust keeps the earliest bars and scales the later ones
+	ForwardAdjust
+)
+
+// adjustEvent multipliers of the bars before day
+type adjustEvent struct {
+	day           int
+	price, volume float64
+}
+
+// civilDay date of t in its location, e.g. 20201204
+func civilDay(t time.Time) int {
+	y, m, d := t.Date()
+	return y*10000 + int(m)*100 + d
+}
+
+// Adjust returns a copy of r with open, high, low, close and volume adjusted
+// for the splits and dividends in Events, so indicators on highs and lows are
+// consistent with the adjusted close.
+// An event applies to the bars dated before its date in the exchange's location.
+// A split of ratio R divides prices by R and multiplies volume by R, and
+// a dividend D multiplies prices by 1 - D/C, where C is the close before its ex-date;
+// a dividend without a close before it is ignored.
+// Yahoo's chart prices and dividends are already split-adjusted, so unless adjust
+// has RawPrices the splits are undone first, e.g. AdjustDividends alone returns
+// prices as traded adjusted for dividends.
+// Adjclose of the copy is nil, Bars uses the adjusted close instead.
+func (r *Result) Adjust(adjust Adjustment, mode AdjustMode) (*Result, error) {
+	c := r.clone()
+	q, _, err := c.series()
+	if err != nil {
+		return nil, errors.Wrapf(err, "series")
+	}
+	c.Indicators.Adjclose = nil
+	if q == nil {
+		return c, nil
+	}
+
+	loc := r.Meta.Location()
+	days := make([]int, len(c.Timestamp))
+	for i, ts := range c.Timestamp {
+		days[i] = civilDay(time.Unix(ts, 0).In(loc))
+	}
+
+	// splits to undo and to apply
+	var undo, events []adjustEvent
+	for _, s := range r.Splits() {
+		ratio, _ := s.Ratio.Float64()
+		if adjust&RawPrices == 0 {
+			undo = append(undo, adjustEvent{day: civilDay(s.Time), price: ratio, volume: 1 / ratio})
+		}
+		if adjust&AdjustSplits != 0 {
+			events = append(events, adjustEvent{day: civilDay(s.Time), price: 1 / ratio, volume: ratio})
+		}
+	}
+	// the ratio of a dividend to the close is the same before and after undoing splits
+	if adjust&AdjustDividends != 0 {
+		for _, d := range r.Dividends() {
+			day := civilDay(d.Time)
+			var prev NullFloat64
+			for i := 0; i < len(days) && days[i] < day; i++ {
+				if q.Close[i].Valid {
+					prev = q.Close[i]
+				}
+			}
+			if !prev.Valid {
+				continue
+			}
+			m := 1 - d.Amount/prev.Float64
+			if m <= 0 {
+				return nil, errors.Errorf("dividend %v on %s not less than close %v", d.Amount, d.Time.Format(DateLayout), prev.Float64)
+			}
+			events = append(events, adjustEvent{day: day, price: m, volume: 1})
+		}
+	}
+
+	price, volume := adjustFactors(days, events)
+	if mode == ForwardAdjust {
+		for i := len(days) - 1; i >= 0; i-- {
+			price[i] /= price[0]
+			volume[i] /= volume[0]
+		}
+	}
+	rawPrice, rawVolume := adjustFactors(days, undo)
+
+	for i := range days {
+		for _, field := range [][]NullFloat64{q.Open, q.High, q.Low, q.Close} {
+			if field[i].Valid {
+				field[i].Float64 *= rawPrice[i] * price[i]
+			}
+		}
+		if q.Volume[i].Valid {
+			q.Volume[i].Float64 = math.Round(q.Volume[i].Float64 * rawVolume[i] * volume[i])
+		}
+	}
+
+	return c, nil
+}
+
+// adjustFactors of each bar by the events after it, accumulated from the latest bar
+func adjustFactors(days []int, events []adjustEvent) ([]float64, []float64) {
+	sort.Slice(events, func(i, j int) bool { return events[i].day < events[j].day })
+
+	price, volume := make([]float64, len(days)), make([]float64, len(days))
+	p, v, e := 1.0, 1.0, len(events)-1
+	for i := len(days) - 1; i >= 0; i-- {
+		for ; e >= 0 && events[e].day > days[i]; e-- {
+			p *= events[e].price
+			v *= events[e].volume
+		}
+		price[i], volume[i] = p, v
+	}
+	return price, volume
+}
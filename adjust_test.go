@@ -0,0 +1,134 @@
+package yahoofinance
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func testAdjustResult() *Result {
+	r := testResult(Meta{}, []int64{0, 86400, 172800, 259200}, 1, 8, 6, 3, 4)
+	r.Events = Events{
+		Dividends: map[string]Dividend{
+			"86400":  {Amount: 2, Date: 86400},
+			"-86400": {Amount: 1, Date: -86400},
+		},
+		Splits: map[string]Split{
+			"172800": {Date: 172800, Numerator: 2, Denominator: 1, SplitRatio: "2:1"},
+		},
+	}
+	r.Indicators.Quote[0].Volume = nullFloats(100, 100, 200, math.NaN())
+	r.Indicators.Adjclose = []Adjclose{{nullFloats(3, 3, 3, 4)}}
+	return r
+}
+
+// testYahooAdjustResult testAdjustResult split-adjusted like Yahoo's chart
+func testYahooAdjustResult() *Result {
+	r := testAdjustResult()
+	r.Events.Dividends = map[string]Dividend{
+		"86400":  {Amount: 1, Date: 86400},
+		"-86400": {Amount: 0.5, Date: -86400},
+	}
+	r.Indicators.Quote[0] = Quote{
+		Volume: nullFloats(200, 200, 200, math.NaN()),
+		Close:  nullFloats(4, 3, 3, 4),
+		Open:   nullFloats(4, 3, 3, 4),
+		High:   nullFloats(4.5, 3.5, 4, 5),
+		Low:    nullFloats(3.5, 2.5, 2, 3),
+	}
+	return r
+}
+
+func TestResult_Adjust(t *testing.T) {
+	nan := math.NaN()
+	overflow := testAdjustResult()
+	overflow.Events.Dividends["86400"] = Dividend{Amount: 8, Date: 86400}
+	all := Quote{
+		Volume: nullFloats(200, 200, 200, nan),
+		Close:  nullFloats(3, 3, 3, 4),
+		Open:   nullFloats(3, 3, 3, 4),
+		High:   nullFloats(3.375, 3.5, 4, 5),
+		Low:    nullFloats(2.625, 2.5, 2, 3),
+	}
+	dividends := Quote{
+		Volume: nullFloats(100, 100, 200, nan),
+		Close:  nullFloats(6, 6, 3, 4),
+		Open:   nullFloats(6, 6, 3, 4),
+		High:   nullFloats(6.75, 7, 4, 5),
+		Low:    nullFloats(5.25, 5, 2, 3),
+	}
+	forward := Quote{
+		Volume: nullFloats(100, 100, 100, nan),
+		Close:  nullFloats(8, 8, 8, 32.0/3),
+		Open:   nullFloats(8, 8, 8, 32.0/3),
+		High:   nullFloats(9, 28.0/3, 32.0/3, 40.0/3),
+		Low:    nullFloats(7, 20.0/3, 16.0/3, 8),
+	}
+
+	tests := []struct {
+		name    string
+		r       *Result
+		adjust  Adjustment
+		mode    AdjustMode
+		want    Quote
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"All", testAdjustResult(), AdjustAll | RawPrices, BackAdjust, all, false},
+		{"Splits", testAdjustResult(), AdjustSplits | RawPrices, BackAdjust, Quote{
+			Volume: nullFloats(200, 200, 200, nan),
+			Close:  nullFloats(4, 3, 3, 4),
+			Open:   nullFloats(4, 3, 3, 4),
+			High:   nullFloats(4.5, 3.5, 4, 5),
+			Low:    nullFloats(3.5, 2.5, 2, 3),
+		}, false},
+		{"Dividends", testAdjustResult(), AdjustDividends | RawPrices, BackAdjust, dividends, false},
+		{"Forward", testAdjustResult(), AdjustAll | RawPrices, ForwardAdjust, forward, false},
+		{"None", testAdjustResult(), RawPrices, ForwardAdjust, testAdjustResult().Indicators.Quote[0], false},
+		{"YahooAll", testYahooAdjustResult(), AdjustAll, BackAdjust, all, false},
+		{"YahooDividends", testYahooAdjustResult(), AdjustDividends, BackAdjust, dividends, false},
+		{"YahooForward", testYahooAdjustResult(), AdjustAll, ForwardAdjust, forward, false},
+		{"YahooSplits", testYahooAdjustResult(), AdjustSplits, BackAdjust, testYahooAdjustResult().Indicators.Quote[0], false},
+		{"YahooNone", testYahooAdjustResult(), 0, BackAdjust, testAdjustResult().Indicators.Quote[0], false},
+		{"Overflow", overflow, AdjustDividends | RawPrices, BackAdjust, Quote{}, true},
+		{"Mismatch", &Result{Timestamp: []int64{1}, Indicators: Indicators{Quote: []Quote{{}}}}, AdjustAll, BackAdjust, Quote{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			close := append([]NullFloat64(nil), tt.r.Indicators.Quote[0].Close...)
+			got, err := tt.r.Adjust(tt.adjust, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Result.Adjust() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Indicators.Adjclose != nil {
+				t.Errorf("Result.Adjust() Adjclose = %v, want nil", got.Indicators.Adjclose)
+			}
+			if !reflect.DeepEqual(got.Timestamp, tt.r.Timestamp) {
+				t.Errorf("Result.Adjust() Timestamp = %v, want %v", got.Timestamp, tt.r.Timestamp)
+			}
+
+			q := got.Indicators.Quote[0]
+			for _, f := range []struct {
+				name      string
+				got, want []NullFloat64
+			}{
+				{"Volume", q.Volume, tt.want.Volume}, {"Close", q.Close, tt.want.Close}, {"Open", q.Open, tt.want.Open},
+				{"High", q.High, tt.want.High}, {"Low", q.Low, tt.want.Low},
+			} {
+				for i := range f.want {
+					if f.got[i].Valid != f.want[i].Valid || math.Abs(f.got[i].Float64-f.want[i].Float64) > 1e-9 {
+						t.Errorf("Result.Adjust() %s = %v, want %v", f.name, f.got, f.want)
+						break
+					}
+				}
+			}
+			if q := tt.r.Indicators.Quote[0]; !reflect.DeepEqual(q.Close, close) {
+				t.Errorf("Result.Adjust() modified r, Close = %v, want %v", q.Close, close)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package yahoofinance
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Authenticator authorizes requests before sending
+type Authenticator interface {
+	// Authenticate adds credentials to req, fetching them by client if needed
+	Authenticate(ctx context.Context, client *http.Client, req *http.Request) error
+	// Invalidate drops the cached credentials rejected by the server
+	Invalidate()
+}
+
+// NewCrumbAuthenticator get cookies from https://fc.yahoo.com
+func NewCrumbAuthenticator() *CrumbAuthenticator {
+	return &CrumbAuthenticator{CookieURL: "https://fc.yahoo.com"}
+}
+
+// CrumbAuthenticator obtains the A1/A3 cookies and a crumb,
+// then adds the crumb to the query of every request
+// The client must have a cookie jar, e.g. GetClient
+type CrumbAuthenticator struct {
+	// CookieURL is visited to set the cookies
+	CookieURL string
+
+	mu    sync.Mutex
+	crumb string
+}
+
+// Authenticate implements Authenticator
+func (a *CrumbAuthenticator) Authenticate(ctx context.Context, client *http.Client, req *http.Request) error {
+	crumb, err := a.getCrumb(ctx, client, req)
+	if err != nil {
+		return errors.Wrapf(err, "getCrumb")
+	}
+
+	q := req.URL.Query()
+	q.Set("crumb", crumb)
+	req.URL.RawQuery = q.Encode()
+
+	return nil
+}
+
+// Invalidate implements Authenticator
+func (a *CrumbAuthenticator) Invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.crumb = ""
+}
+
+// getCrumb returns the cached crumb or fetches it from the host of req
+func (a *CrumbAuthenticator) getCrumb(ctx context.Context, client *http.Client, req *http.Request) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.crumb != "" {
+		return a.crumb, nil
+	}
+	if client.Jar == nil {
+		return "", errors.New("client has no cookie jar")
+	}
+
+	get := func(urls string) (*http.Response, error) {
+		r, err := http.NewRequest("GET", urls, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "http.NewRequest")
+		}
+		r.Header.Set("User-Agent", req.Header.Get("User-Agent"))
+		return SendRequest(ctx, client, r)
+	}
+
+	// the cookies are set whatever the status code is
+	res, err := get(a.CookieURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "get cookie")
+	}
+	res.Body.Close()
+
+	res, err = get(req.URL.Scheme + "://" + req.URL.Host + "/v1/test/getcrumb")
+	if err != nil {
+		return "", errors.Wrapf(err, "get crumb")
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return "", errors.Wrapf(err, "CheckResponse")
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "ioutil.ReadAll")
+	}
+	crumb := strings.TrimSpace(string(b))
+	if crumb == "" || strings.ContainsAny(crumb, "<{") {
+		return "", errors.Errorf("invalid crumb %q", crumb)
+	}
+	a.crumb = crumb
+
+	return crumb, nil
+}
+
+// authorize middleware authorizes requests by auth,
+// and refreshes the credentials once if they are rejected
+func authorize(client *http.Client, auth Authenticator) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			for refreshed := false; ; refreshed = true {
+				if err := auth.Authenticate(req.Context(), client, req); err != nil {
+					return nil, errors.Wrapf(err, "Authenticate")
+				}
+				res, err := next(req)
+				if err != nil || res.StatusCode != http.StatusUnauthorized || refreshed {
+					return res, err
+				}
+
+				io.Copy(ioutil.Discard, res.Body)
+				res.Body.Close()
+				auth.Invalidate()
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package yahoofinance
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// crumbServer stands in for Yahoo, the crumb expires after expireAfter quotes
+type crumbServer struct {
+	mu          sync.Mutex
+	valid       string
+	crumbs      int
+	quotes      int
+	expireAfter int
+}
+
+func (s *crumbServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/consent":
+		http.SetCookie(w, &http.Cookie{Name: "A3", Value: "d=cookie", Path: "/"})
+		w.WriteHeader(http.StatusNotFound)
+	case "/v1/test/getcrumb":
+		if _, err := r.Cookie("A3"); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.crumbs++
+		s.valid = fmt.Sprintf("crumb%d", s.crumbs)
+		fmt.Fprint(w, s.valid)
+	case "/v7/finance/quote":
+		if s.valid == "" || r.URL.Query().Get("crumb") != s.valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"finance":{"result":null,"error":{"code":"Unauthorized","description":"Invalid Crumb"}}}`)
+			return
+		}
+		s.quotes++
+		if s.expireAfter > 0 && s.quotes%s.expireAfter == 0 {
+			s.valid = ""
+		}
+		fmt.Fprint(w, `{"quoteResponse":{"result":[{"symbol":"VTI"}],"error":null}}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestCrumbAuthenticator(t *testing.T) {
+	tests := []struct {
+		name        string
+		expireAfter int
+		calls       int
+		wantCrumbs  int
+	}{
+		// TODO: Add test cases.
+		{"Cached", 0, 3, 1},
+		{"Refresh", 1, 3, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &crumbServer{expireAfter: tt.expireAfter}
+			ts := httptest.NewServer(server)
+			defer ts.Close()
+
+			yfinanceTest, _ := New(GetClient())
+			yfinanceTest.host = ts.URL
+			auth := NewCrumbAuthenticator()
+			auth.CookieURL = ts.URL + "/consent"
+			yfinanceTest.SetAuthenticator(auth)
+
+			for i := 0; i < tt.calls; i++ {
+				rsp, err := yfinanceTest.Quote.Symbols("VTI").Do()
+				if err != nil {
+					t.Fatalf("SymbolsCall.Do() error = %v", err)
+				}
+				if got := rsp.QuoteResponse.Result[0].Symbol; got != "VTI" {
+					t.Errorf("SymbolsCall.Do() symbol = %v, want VTI", got)
+				}
+			}
+			if server.crumbs != tt.wantCrumbs {
+				t.Errorf("getcrumb called %d times, want %d", server.crumbs, tt.wantCrumbs)
+			}
+		})
+	}
+}
+
+func TestCrumbAuthenticator_NoCookie(t *testing.T) {
+	ts := httptest.NewServer(&crumbServer{})
+	defer ts.Close()
+
+	yfinanceTest, _ := New(GetClient())
+	yfinanceTest.host = ts.URL
+	auth := NewCrumbAuthenticator()
+	auth.CookieURL = ts.URL + "/nothing"
+	yfinanceTest.SetAuthenticator(auth)
+
+	if _, err := yfinanceTest.Quote.Symbols("VTI").Do(); err == nil {
+		t.Error("SymbolsCall.Do() should fail without cookie")
+	}
+}
@@ -0,0 +1,288 @@
+package yahoofinance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Bar one candle of history
+type Bar struct {
+	// Time is the start of the interval in the exchange's location.
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume int64
+	// AdjClose is the close adjusted for splits and dividends.
+	// It equals Close when Yahoo does not send adjusted close,
+	// e.g. intraday intervals or includeAdjustedClose=false.
+	AdjClose float64
+}
+
+// Location returns the exchange's location, falling back to
+// a fixed zone built from Timezone and Gmtoffset
+func (m *Meta) Location() *time.Location {
+	if m.ExchangeTimezoneName != "" {
+		if loc, err := time.LoadLocation(m.ExchangeTimezoneName); err == nil {
+			return loc
+		}
+	}
+	if m.Timezone == "" && m.Gmtoffset == 0 {
+		return time.UTC
+	}
+
+	return time.FixedZone(m.Timezone, int(m.Gmtoffset))
+}
+
+// series validates and returns the first quote and adjclose, adjclose is nil if absent
+func (r *Result) series() (*Quote, []NullFloat64, error) {
+	if len(r.Indicators.Quote) == 0 {
+		if len(r.Timestamp) == 0 {
+			return nil, nil, nil
+		}
+		return nil, nil, errors.Errorf("%d timestamps without quote", len(r.Timestamp))
+	}
+
+	n := len(r.Timestamp)
+	q := &r.Indicators.Quote[0]
+	for _, field := range [][]NullFloat64{q.Open, q.High, q.Low, q.Close, q.Volume} {
+		if len(field) != n {
+			return nil, nil, errors.Errorf("quote length %d mismatch timestamp length %d", len(field), n)
+		}
+	}
+	var adjclose []NullFloat64
+	if len(r.Indicators.Adjclose) > 0 {
+		adjclose = r.Indicators.Adjclose[0].Value
+		if len(adjclose) != n {
+			return nil, nil, errors.Errorf("adjclose length %d mismatch timestamp length %d", len(adjclose), n)
+		}
+	}
+
+	return q, adjclose, nil
+}
+
+// missing reports whether any price of interval i is null
+func (q *Quote) missing(i int) bool {
+	return !q.Open[i].Valid || !q.High[i].Valid || !q.Low[i].Valid || !q.Close[i].Valid
+}
+
+// Bars zip Timestamp and Indicators into candles
+// Intervals with null prices (e.g. trading halts) are skipped,
+// use ForwardFill or Interpolate first to keep them
+func (r *Result) Bars() ([]Bar, error) {
+	q, adjclose, err := r.series()
+	if err != nil {
+		return nil, errors.Wrapf(err, "series")
+	}
+	if q == nil {
+		return nil, nil
+	}
+
+	loc := r.Meta.Location()
+	bars := make([]Bar, 0, len(r.Timestamp))
+	for i, ts := range r.Timestamp {
+		if q.missing(i) {
+			continue
+		}
+
+		bar := Bar{
+			Time:     time.Unix(ts, 0).In(loc),
+			Open:     q.Open[i].Float64,
+			High:     q.High[i].Float64,
+			Low:      q.Low[i].Float64,
+			Close:    q.Close[i].Float64,
+			Volume:   int64(q.Volume[i].Float64),
+			AdjClose: q.Close[i].Float64,
+		}
+		if adjclose != nil && adjclose[i].Valid {
+			bar.AdjClose = adjclose[i].Float64
+		}
+		bars = append(bars, bar)
+	}
+
+	return bars, nil
+}
+
+// clone copies Timestamp and Indicators, Meta and Events are shared
+func (r *Result) clone() *Result {
+	c := *r
+	c.Timestamp = append([]int64(nil), r.Timestamp...)
+	c.Indicators.Quote = make([]Quote, len(r.Indicators.Quote))
+	for i, q := range r.Indicators.Quote {
+		c.Indicators.Quote[i] = Quote{
+			Volume: append([]NullFloat64(nil), q.Volume...),
+			Close:  append([]NullFloat64(nil), q.Close...),
+			Open:   append([]NullFloat64(nil), q.Open...),
+			High:   append([]NullFloat64(nil), q.High...),
+			Low:    append([]NullFloat64(nil), q.Low...),
+		}
+	}
+	c.Indicators.Adjclose = make([]Adjclose, len(r.Indicators.Adjclose))
+	for i, a := range r.Indicators.Adjclose {
+		c.Indicators.Adjclose[i] = Adjclose{Value: append([]NullFloat64(nil), a.Value...)}
+	}
+
+	return &c
+}
+
+// DropMissing returns a copy of r without the intervals with null prices
+func (r *Result) DropMissing() (*Result, error) {
+	q, adjclose, err := r.series()
+	if err != nil {
+		return nil, errors.Wrapf(err, "series")
+	}
+	c := r.clone()
+	if q == nil {
+		return c, nil
+	}
+
+	c.Timestamp = c.Timestamp[:0]
+	cq := &c.Indicators.Quote[0]
+	*cq = Quote{}
+	var cadj []NullFloat64
+	for i, ts := range r.Timestamp {
+		if q.missing(i) {
+			continue
+		}
+		c.Timestamp = append(c.Timestamp, ts)
+		cq.Volume = append(cq.Volume, q.Volume[i])
+		cq.Close = append(cq.Close, q.Close[i])
+		cq.Open = append(cq.Open, q.Open[i])
+		cq.High = append(cq.High, q.High[i])
+		cq.Low = append(cq.Low, q.Low[i])
+		if adjclose != nil {
+			cadj = append(cadj, adjclose[i])
+		}
+	}
+	if adjclose != nil {
+		c.Indicators.Adjclose[0].Value = cadj
+	}
+
+	return c, nil
+}
+
+// ForwardFill returns a copy of r with null prices filled by the last close,
+// null adjclose by the last adjclose and null volume by 0
+// Leading nulls are kept
+func (r *Result) ForwardFill() (*Result, error) {
+	c := r.clone()
+	q, adjclose, err := c.series()
+	if err != nil {
+		return nil, errors.Wrapf(err, "series")
+	}
+	if q == nil {
+		return c, nil
+	}
+
+	var last, lastAdj NullFloat64
+	for i := range c.Timestamp {
+		for _, field := range [][]NullFloat64{q.Open, q.High, q.Low, q.Close} {
+			if !field[i].Valid {
+				field[i] = last
+			}
+		}
+		last = q.Close[i]
+		if !q.Volume[i].Valid {
+			q.Volume[i] = NullFloat64{Float64: 0, Valid: true}
+		}
+		if adjclose != nil {
+			if !adjclose[i].Valid {
+				adjclose[i] = lastAdj
+			}
+			lastAdj = adjclose[i]
+		}
+	}
+
+	return c, nil
+}
+
+// Interpolate returns a copy of r with null prices linearly interpolated
+// in time between the surrounding values and null volume filled by 0
+// Leading and trailing nulls are kept
+func (r *Result) Interpolate() (*Result, error) {
+	c := r.clone()
+	q, adjclose, err := c.series()
+	if err != nil {
+		return nil, errors.Wrapf(err, "series")
+	}
+	if q == nil {
+		return c, nil
+	}
+
+	for _, field := range [][]NullFloat64{q.Open, q.High, q.Low, q.Close, adjclose} {
+		interpolate(c.Timestamp, field)
+	}
+	for i, v := range q.Volume {
+		if !v.Valid {
+			q.Volume[i] = NullFloat64{Float64: 0, Valid: true}
+		}
+	}
+
+	return c, nil
+}
+
+// interpolate fills the nulls of v lying between two values in place
+func interpolate(ts []int64, v []NullFloat64) {
+	prev := -1
+	for i := range v {
+		if !v[i].Valid {
+			continue
+		}
+		if prev >= 0 && i-prev > 1 {
+			span := float64(ts[i] - ts[prev])
+			for j := prev + 1; j < i; j++ {
+				w := float64(ts[j]-ts[prev]) / span
+				v[j] = NullFloat64{Float64: v[prev].Float64 + (v[i].Float64-v[prev].Float64)*w, Valid: true}
+			}
+		}
+		prev = i
+	}
+}
+
+// Bars candles of the first result
+func (info *Infomation) Bars() ([]Bar, error) {
+	if len(info.Chart.Result) == 0 {
+		return nil, errors.New("no result")
+	}
+
+	return info.Chart.Result[0].Bars()
+}
+
+// validate reports the error embedded in a successful chart response
+// and a null or empty result, strict also rejects results without bars
+// and lengths of Indicators mismatching Timestamp
+func (info *Infomation) validate(strict bool) error {
+	if e := info.Chart.Error; e.Code != "" || e.Description != "" {
+		return &Error{
+			Code:        info.HTTPStatusCode,
+			Message:     fmt.Sprintf("API: code %s with description: %s", e.Code, e.Description),
+			YahooCode:   e.Code,
+			Description: e.Description,
+			Header:      info.Header,
+		}
+	}
+	if info.Chart.Result == nil {
+		return errors.Wrapf(ErrNoData, "chart result is null")
+	}
+	if len(info.Chart.Result) == 0 {
+		return errors.Wrapf(ErrNoData, "chart result is empty")
+	}
+	if !strict {
+		return nil
+	}
+
+	for i := range info.Chart.Result {
+		r := &info.Chart.Result[i]
+		if len(r.Timestamp) == 0 {
+			return errors.Wrapf(ErrNoData, "no bars of %s", r.Meta.Symbol)
+		}
+		if _, _, err := r.series(); err != nil {
+			return errors.Wrapf(err, "series of %s", r.Meta.Symbol)
+		}
+	}
+
+	return nil
+}
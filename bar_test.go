@@ -0,0 +1,225 @@
+package yahoofinance
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMeta_Location(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		name string
+		m    *Meta
+		want string
+	}{
+		// TODO: Add test cases.
+		{"Exchange", &Meta{ExchangeTimezoneName: "America/New_York", Timezone: "EST", Gmtoffset: -18000}, newYork.String()},
+		{"Fixed", &Meta{ExchangeTimezoneName: "Unknown/Zone", Timezone: "CST", Gmtoffset: 28800}, "CST"},
+		{"Empty", &Meta{}, "UTC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Location().String(); got != tt.want {
+				t.Errorf("Meta.Location() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Bars(t *testing.T) {
+	loc := time.FixedZone("EST", -18000)
+	meta := Meta{Timezone: "EST", Gmtoffset: -18000}
+	nan := math.NaN()
+
+	tests := []struct {
+		name    string
+		r       *Result
+		want    []Bar
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", &Result{
+			Meta:      meta,
+			Timestamp: []int64{992611800, 992871000, 1607092200},
+			Indicators: Indicators{
+				Quote: []Quote{{
+					Volume: nullFloats(1067400, nan, 4401400),
+					Close:  nullFloats(55.665, nan, 191.51),
+					Open:   nullFloats(55.425, nan, 190),
+					High:   nullFloats(56.005, nan, 191.51),
+					Low:    nullFloats(55.175, nan, 189.99),
+				}},
+				Adjclose: []Adjclose{{nullFloats(38.816, nan, 191.51)}},
+			},
+		}, []Bar{
+			{Time: time.Unix(992611800, 0).In(loc), Open: 55.425, High: 56.005, Low: 55.175, Close: 55.665, Volume: 1067400, AdjClose: 38.816},
+			{Time: time.Unix(1607092200, 0).In(loc), Open: 190, High: 191.51, Low: 189.99, Close: 191.51, Volume: 4401400, AdjClose: 191.51},
+		}, false},
+		{"NoAdjclose", &Result{
+			Meta:      meta,
+			Timestamp: []int64{992611800},
+			Indicators: Indicators{
+				Quote: []Quote{{
+					Volume: nullFloats(1067400),
+					Close:  nullFloats(55.665),
+					Open:   nullFloats(55.425),
+					High:   nullFloats(56.005),
+					Low:    nullFloats(55.175),
+				}},
+			},
+		}, []Bar{
+			{Time: time.Unix(992611800, 0).In(loc), Open: 55.425, High: 56.005, Low: 55.175, Close: 55.665, Volume: 1067400, AdjClose: 55.665},
+		}, false},
+		{"Empty", &Result{Meta: meta}, nil, false},
+		{"Mismatch", &Result{
+			Meta:      meta,
+			Timestamp: []int64{992611800, 992871000},
+			Indicators: Indicators{
+				Quote: []Quote{{
+					Volume: nullFloats(1067400),
+					Close:  nullFloats(55.665),
+					Open:   nullFloats(55.425),
+					High:   nullFloats(56.005),
+					Low:    nullFloats(55.175),
+				}},
+			},
+		}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.r.Bars()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Result.Bars() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Result.Bars() = \n%+v, want \n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testMissingResult() *Result {
+	nan := math.NaN()
+	r := testResult(Meta{}, []int64{0, 60, 120, 180, 240}, 0, nan, 10, nan, nan, 40)
+	r.Indicators.Adjclose = []Adjclose{{nullFloats(nan, 5, nan, nan, 20)}}
+	return r
+}
+
+func TestResult_DropMissing(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       *Result
+		want    *Result
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", testMissingResult(), &Result{
+			Timestamp: []int64{60, 240},
+			Indicators: Indicators{
+				Quote: []Quote{{
+					Volume: nullFloats(1000, 4000),
+					Close:  nullFloats(10, 40),
+					Open:   nullFloats(10, 40),
+					High:   nullFloats(10, 40),
+					Low:    nullFloats(10, 40),
+				}},
+				Adjclose: []Adjclose{{nullFloats(5, 20)}},
+			},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.r.DropMissing()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Result.DropMissing() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Result.DropMissing() = \n%+v, want \n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_ForwardFill(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		name    string
+		r       *Result
+		want    *Result
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", testMissingResult(), &Result{
+			Timestamp: []int64{0, 60, 120, 180, 240},
+			Indicators: Indicators{
+				Quote: []Quote{{
+					Volume: nullFloats(0, 1000, 0, 0, 4000),
+					Close:  nullFloats(nan, 10, 10, 10, 40),
+					Open:   nullFloats(nan, 10, 10, 10, 40),
+					High:   nullFloats(nan, 10, 10, 10, 40),
+					Low:    nullFloats(nan, 10, 10, 10, 40),
+				}},
+				Adjclose: []Adjclose{{nullFloats(nan, 5, 5, 5, 20)}},
+			},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.r.ForwardFill()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Result.ForwardFill() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Result.ForwardFill() = \n%+v, want \n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Interpolate(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		name    string
+		r       *Result
+		want    *Result
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", testMissingResult(), &Result{
+			Timestamp: []int64{0, 60, 120, 180, 240},
+			Indicators: Indicators{
+				Quote: []Quote{{
+					Volume: nullFloats(0, 1000, 0, 0, 4000),
+					Close:  nullFloats(nan, 10, 20, 30, 40),
+					Open:   nullFloats(nan, 10, 20, 30, 40),
+					High:   nullFloats(nan, 10, 20, 30, 40),
+					Low:    nullFloats(nan, 10, 20, 30, 40),
+				}},
+				Adjclose: []Adjclose{{nullFloats(nan, 5, 10, 15, 20)}},
+			},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.r.Interpolate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Result.Interpolate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Result.Interpolate() = \n%+v, want \n%+v", got, tt.want)
+			}
+		})
+	}
+}
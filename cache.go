@@ -0,0 +1,195 @@
+package yahoofinance
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// CacheEntry response stored with its validators
+type CacheEntry struct {
+	ETag         string
+	LastModified string
+	StatusCode   int
+	Header       http.Header
+	Body         []byte
+}
+
+// Cache stores responses by request URL
+// Get and Set must be safe for concurrent use
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+}
+
+// NewMemoryCache in-memory Cache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]*CacheEntry)}
+}
+
+// MemoryCache in-memory Cache
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]*CacheEntry
+}
+
+// Get implements Cache
+func (c *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.entries[key]
+	return e, ok
+}
+
+// Set implements Cache
+func (c *MemoryCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = entry
+}
+
+// NewDiskCache Cache in dir, one JSON file per request URL
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "os.MkdirAll")
+	}
+
+	return &DiskCache{dir: dir}, nil
+}
+
+// DiskCache on-disk Cache, unreadable or unwritable entries are treated as misses
+type DiskCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get implements Cache
+func (c *DiskCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	e := &CacheEntry{}
+	if err := json.Unmarshal(b, e); err != nil {
+		return nil, false
+	}
+	return e, true
+}
+
+// Set implements Cache
+func (c *DiskCache) Set(key string, entry *CacheEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// write then rename, so a crash never leaves a partial entry
+	path := c.path(key)
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+	}
+}
+
+// cache middleware sends If-None-Match and If-Modified-Since from the stored
+// validators and serves a 304 from the store as the cached response
+// Requests with their own conditional headers pass through unchanged
+func cache(store Cache) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet ||
+				req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+				return next(req)
+			}
+
+			key := req.URL.String()
+			entry, ok := store.Get(key)
+			if ok {
+				req = req.Clone(req.Context())
+				if entry.ETag != "" {
+					req.Header.Set("If-None-Match", entry.ETag)
+				}
+				if entry.LastModified != "" {
+					req.Header.Set("If-Modified-Since", entry.LastModified)
+				}
+			}
+
+			res, err := next(req)
+			if err != nil {
+				return res, err
+			}
+			if res.StatusCode == http.StatusNotModified && ok {
+				res.Body.Close()
+				return entry.response(req), nil
+			}
+			if res.StatusCode < 200 || res.StatusCode > 299 {
+				return res, nil
+			}
+
+			etag, modified := res.Header.Get("ETag"), res.Header.Get("Last-Modified")
+			if etag == "" && modified == "" {
+				return res, nil
+			}
+			b, err := ioutil.ReadAll(res.Body)
+			res.Body.Close()
+			if err != nil {
+				return nil, errors.Wrapf(err, "ioutil.ReadAll")
+			}
+			store.Set(key, &CacheEntry{
+				ETag:         etag,
+				LastModified: modified,
+				StatusCode:   res.StatusCode,
+				Header:       res.Header,
+				Body:         b,
+			})
+			res.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+			return res, nil
+		}
+	}
+}
+
+// response rebuilds the stored response of req
+func (e *CacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.StatusCode),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// SetCache stores responses with ETag or Last-Modified in store and
+// revalidates them by conditional requests, nil disables caching
+func (s *Service) SetCache(store Cache) {
+	s.cache = store
+}
@@ -0,0 +1,97 @@
+package yahoofinance
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestService_SetCache(t *testing.T) {
+	disk, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		store Cache
+		etag  string
+		// lastModified is sent when etag is empty
+		lastModified string
+	}{
+		// TODO: Add test cases.
+		{"MemoryETag", NewMemoryCache(), `"v1"`, ""},
+		{"MemoryLastModified", NewMemoryCache(), "", "Mon, 07 Dec 2020 00:00:00 GMT"},
+		{"Disk", disk, `"v1"`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conditional, full int
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if (tt.etag != "" && r.Header.Get("If-None-Match") == tt.etag) ||
+					(tt.lastModified != "" && r.Header.Get("If-Modified-Since") == tt.lastModified) {
+					conditional++
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				full++
+				if tt.etag != "" {
+					w.Header().Set("ETag", tt.etag)
+				}
+				if tt.lastModified != "" {
+					w.Header().Set("Last-Modified", tt.lastModified)
+				}
+				fmt.Fprint(w, `{"chart":{"result":[{"meta":{"symbol":"VTI","regularMarketPrice":191.51}}],"error":null}}`)
+			}))
+			defer ts.Close()
+
+			yfinanceTest, _ := New(GetClient(), WithHost(ts.URL), WithCache(tt.store))
+			for i := 0; i < 3; i++ {
+				info, err := yfinanceTest.History.Period("VTI", Range1d, Interval1d).Do()
+				if err != nil {
+					t.Fatalf("call %d error = %v", i, err)
+				}
+				if got := info.Chart.Result[0].Meta.RegularMarketPrice; got != 191.51 {
+					t.Errorf("call %d RegularMarketPrice = %v, want 191.51", i, got)
+				}
+				if info.HTTPStatusCode != http.StatusOK {
+					t.Errorf("call %d HTTPStatusCode = %v, want 200", i, info.HTTPStatusCode)
+				}
+			}
+			if full != 1 || conditional != 2 {
+				t.Errorf("full %d conditional %d, want 1 and 2", full, conditional)
+			}
+
+			// own conditional headers bypass the cache and get the raw 304
+			_, err := yfinanceTest.History.Period("VTI", Range1d, Interval1d).
+				SetHeader("If-None-Match", tt.etag).SetHeader("If-Modified-Since", tt.lastModified).Do()
+			if err == nil {
+				t.Error("Do() with own conditional headers error = nil, want 304")
+			}
+		})
+	}
+}
+
+func TestService_SetCache_NoValidator(t *testing.T) {
+	var count int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if r.Header.Get("If-None-Match") != "" || r.Header.Get("If-Modified-Since") != "" {
+			t.Errorf("conditional request without validators")
+		}
+		fmt.Fprint(w, `{"chart":{"result":[{"meta":{"symbol":"VTI"}}],"error":null}}`)
+	}))
+	defer ts.Close()
+
+	store := NewMemoryCache()
+	yfinanceTest, _ := New(GetClient(), WithHost(ts.URL), WithCache(store))
+	for i := 0; i < 2; i++ {
+		if _, err := yfinanceTest.History.Period("VTI", Range1d, Interval1d).Do(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if count != 2 || len(store.entries) != 0 {
+		t.Errorf("requests %d entries %d, want 2 and 0", count, len(store.entries))
+	}
+}
@@ -0,0 +1,290 @@
+package yahoofinance
+
+import (
+	"encoding/csv"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// date layouts of CSV
+const (
+	DateLayout           = "2006-01-02"
+	DateTimeLayout       = "2006-01-02 15:04:05"
+	DateTimeOffsetLayout = "2006-01-02 15:04:05-07:00"
+)
+
+// csvDateFormats tried in order when reading without CSVOptions.Layout
+var csvDateFormats = []string{DateLayout, DateTimeLayout, time.RFC3339, DateTimeOffsetLayout, "01/02/2006", "2006/01/02"}
+
+// csvHeader Yahoo's classic history CSV
+var csvHeader = []string{"Date", "Open", "High", "Low", "Close", "Adj Close", "Volume"}
+
+// csvNull missing value
+const csvNull = "null"
+
+// CSVOptions date layout and location of CSV, the zero value uses the defaults
+type CSVOptions struct {
+	// Layout of Date, Default writes DateLayout, or DateTimeOffsetLayout for intraday
+	// granularity so it is read back at the same time, and reads any of the common layouts.
+	Layout string
+	// Location of Date, Default writes the exchange's location and reads UTC.
+	Location *time.Location
+}
+
+// layout for writing r
+func (o *CSVOptions) layout(r *Result) string {
+	if o != nil && o.Layout != "" {
+		return o.Layout
+	}
+	if Interval(r.Meta.DataGranularity).Intraday() {
+		return DateTimeOffsetLayout
+	}
+	return DateLayout
+}
+
+// location for writing r, or reading if r is nil
+func (o *CSVOptions) location(r *Result) *time.Location {
+	if o != nil && o.Location != nil {
+		return o.Location
+	}
+	if r != nil {
+		return r.Meta.Location()
+	}
+	return time.UTC
+}
+
+// meta of the location for reading
+// with the zone at the first of timestamps, or now without any
+func (o *CSVOptions) meta(timestamps []int64) Meta {
+	loc := o.location(nil)
+	at := time.Now()
+	if len(timestamps) > 0 {
+		at = time.Unix(timestamps[0], 0)
+	}
+	name, offset := at.In(loc).Zone()
+	return Meta{ExchangeTimezoneName: loc.String(), Timezone: name, Gmtoffset: int64(offset)}
+}
+
+// parse date v
+func (o *CSVOptions) parse(v string) (time.Time, error) {
+	formats := csvDateFormats
+	if o != nil && o.Layout != "" {
+		formats = []string{o.Layout}
+	}
+	return parseDate(v, o.location(nil), formats...)
+}
+
+// formatNull formats v, csvNull if null
+func formatNull(v NullFloat64) string {
+	if !v.Valid {
+		return csvNull
+	}
+	return strconv.FormatFloat(v.Float64, 'f', -1, 64)
+}
+
+// parseNull parses s, csvNull or empty is null
+func parseNull(s string) (NullFloat64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == csvNull {
+		return NullFloat64{}, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return NullFloat64{}, errors.Wrapf(err, "strconv.ParseFloat")
+	}
+	return NullFloat64{Float64: v, Valid: true}, nil
+}
+
+// WriteCSV writes r in Yahoo's classic Date,Open,High,Low,Close,Adj Close,Volume format
+// Missing values are written as null and Adj Close is Close if absent
+func WriteCSV(w io.Writer, r *Result, opts *CSVOptions) error {
+	q, adjclose, err := r.series()
+	if err != nil {
+		return errors.Wrapf(err, "series")
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return errors.Wrapf(err, "csv.Write")
+	}
+	if q != nil {
+		layout, loc := opts.layout(r), opts.location(r)
+		for i, ts := range r.Timestamp {
+			adj := q.Close[i]
+			if adjclose != nil {
+				adj = adjclose[i]
+			}
+			volume := csvNull
+			if q.Volume[i].Valid {
+				volume = strconv.FormatInt(int64(q.Volume[i].Float64), 10)
+			}
+			row := []string{
+				time.Unix(ts, 0).In(loc).Format(layout),
+				formatNull(q.Open[i]),
+				formatNull(q.High[i]),
+				formatNull(q.Low[i]),
+				formatNull(q.Close[i]),
+				formatNull(adj),
+				volume,
+			}
+			if err := cw.Write(row); err != nil {
+				return errors.Wrapf(err, "csv.Write")
+			}
+		}
+	}
+	cw.Flush()
+
+	return errors.Wrapf(cw.Error(), "csv.Flush")
+}
+
+// WriteDividendsCSV writes the dividends of r sorted by time as Date,Dividends
+func WriteDividendsCSV(w io.Writer, r *Result, opts *CSVOptions) error {
+	layout, loc := opts.layout(r), opts.location(r)
+	rows := [][]string{{"Date", "Dividends"}}
+	for _, d := range r.Dividends() {
+		rows = append(rows, []string{d.Time.In(loc).Format(layout), strconv.FormatFloat(d.Amount, 'f', -1, 64)})
+	}
+
+	return errors.Wrapf(csv.NewWriter(w).WriteAll(rows), "csv.WriteAll")
+}
+
+// WriteSplitsCSV writes the splits of r sorted by time as Date,Stock Splits, e.g. 2:1
+func WriteSplitsCSV(w io.Writer, r *Result, opts *CSVOptions) error {
+	layout, loc := opts.layout(r), opts.location(r)
+	rows := [][]string{{"Date", "Stock Splits"}}
+	for _, s := range r.Splits() {
+		rows = append(rows, []string{s.Time.In(loc).Format(layout), s.Ratio.Num().String() + ":" + s.Ratio.Denom().String()})
+	}
+
+	return errors.Wrapf(csv.NewWriter(w).WriteAll(rows), "csv.WriteAll")
+}
+
+// readCSV reads rows of rd and locates columns by name, a missing optional column is -1
+func readCSV(rd io.Reader, required []string, optional ...string) ([][]string, map[string]int, error) {
+	rows, err := csv.NewReader(rd).ReadAll()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "csv.ReadAll")
+	}
+	if len(rows) == 0 {
+		return nil, nil, errors.New("no header")
+	}
+
+	cols := map[string]int{}
+	for i, name := range rows[0] {
+		cols[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	for _, name := range required {
+		if _, ok := cols[name]; !ok {
+			return nil, nil, errors.Errorf("column %s not found", name)
+		}
+	}
+	for _, name := range optional {
+		if _, ok := cols[name]; !ok {
+			cols[name] = -1
+		}
+	}
+
+	return rows[1:], cols, nil
+}
+
+// ReadCSV parses Yahoo's classic history CSV back into a Result
+// Adj Close is optional and columns may be in any order.
+// Meta holds the location of opts, so Bars are in that location.
+func ReadCSV(rd io.Reader, opts *CSVOptions) (*Result, error) {
+	rows, cols, err := readCSV(rd, []string{"Date", "Open", "High", "Low", "Close", "Volume"}, "Adj Close")
+	if err != nil {
+		return nil, errors.Wrapf(err, "readCSV")
+	}
+
+	r := &Result{}
+	var q Quote
+	var adjclose []NullFloat64
+	for n, row := range rows {
+		t, err := opts.parse(row[cols["Date"]])
+		if err != nil {
+			return nil, errors.Wrapf(err, "row %d", n+1)
+		}
+		r.Timestamp = append(r.Timestamp, t.Unix())
+
+		for _, f := range []struct {
+			name  string
+			field *[]NullFloat64
+		}{
+			{"Open", &q.Open}, {"High", &q.High}, {"Low", &q.Low}, {"Close", &q.Close}, {"Volume", &q.Volume}, {"Adj Close", &adjclose},
+		} {
+			if cols[f.name] < 0 {
+				continue
+			}
+			v, err := parseNull(row[cols[f.name]])
+			if err != nil {
+				return nil, errors.Wrapf(err, "row %d %s", n+1, f.name)
+			}
+			*f.field = append(*f.field, v)
+		}
+	}
+	r.Meta = opts.meta(r.Timestamp)
+	if len(rows) > 0 {
+		r.Indicators.Quote = []Quote{q}
+		if adjclose != nil {
+			r.Indicators.Adjclose = []Adjclose{{Value: adjclose}}
+		}
+	}
+
+	return r, nil
+}
+
+// ReadDividendsCSV parses Date,Dividends into dividends sorted by time
+func ReadDividendsCSV(rd io.Reader, opts *CSVOptions) ([]DividendEvent, error) {
+	rows, cols, err := readCSV(rd, []string{"Date", "Dividends"})
+	if err != nil {
+		return nil, errors.Wrapf(err, "readCSV")
+	}
+
+	r := &Result{Meta: opts.meta(nil)}
+	r.Events.Dividends = make(map[string]Dividend, len(rows))
+	for n, row := range rows {
+		t, err := opts.parse(row[cols["Date"]])
+		if err != nil {
+			return nil, errors.Wrapf(err, "row %d", n+1)
+		}
+		amount, err := strconv.ParseFloat(strings.TrimSpace(row[cols["Dividends"]]), 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "row %d", n+1)
+		}
+		key := strconv.FormatInt(t.Unix(), 10)
+		r.Events.Dividends[key] = Dividend{Amount: amount, Date: t.Unix()}
+	}
+
+	return r.Dividends(), nil
+}
+
+// ReadSplitsCSV parses Date,Stock Splits into splits sorted by time
+// Ratios may be written as 2:1 or 2/1
+func ReadSplitsCSV(rd io.Reader, opts *CSVOptions) ([]SplitEvent, error) {
+	rows, cols, err := readCSV(rd, []string{"Date", "Stock Splits"})
+	if err != nil {
+		return nil, errors.Wrapf(err, "readCSV")
+	}
+
+	r := &Result{Meta: opts.meta(nil)}
+	r.Events.Splits = make(map[string]Split, len(rows))
+	for n, row := range rows {
+		t, err := opts.parse(row[cols["Date"]])
+		if err != nil {
+			return nil, errors.Wrapf(err, "row %d", n+1)
+		}
+		ratio := strings.TrimSpace(row[cols["Stock Splits"]])
+		if _, ok := new(big.Rat).SetString(strings.Replace(ratio, ":", "/", 1)); !ok {
+			return nil, errors.Errorf("row %d: invalid split ratio %s", n+1, ratio)
+		}
+		key := strconv.FormatInt(t.Unix(), 10)
+		r.Events.Splits[key] = Split{Date: t.Unix(), SplitRatio: strings.Replace(ratio, "/", ":", 1)}
+	}
+
+	return r.Splits(), nil
+}
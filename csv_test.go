@@ -0,0 +1,209 @@
+package yahoofinance
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testCSVResult() *Result {
+	nan := math.NaN()
+	r := testResult(Meta{Symbol: "VTI", Timezone: "EST", Gmtoffset: -18000, DataGranularity: "1d"},
+		[]int64{992611800, 992871000, 1607092200}, 0.5, 55.665, nan, 191.51)
+	r.Events = Events{
+		Dividends: map[string]Dividend{
+			"1601040600": {Amount: 0.674, Date: 1601040600},
+			"993475800":  {Amount: 0.14, Date: 993475800},
+		},
+		Splits: map[string]Split{
+			"1213795800": {Date: 1213795800, Numerator: 2, Denominator: 1, SplitRatio: "2:1"},
+		},
+	}
+	r.Indicators.Adjclose = []Adjclose{{nullFloats(38.816, nan, 191.51)}}
+	return r
+}
+
+func TestWriteCSV(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		name    string
+		r       *Result
+		opts    *CSVOptions
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", testCSVResult(), nil, `Date,Open,High,Low,Close,Adj Close,Volume
+2001-06-15,55.665,56.165,55.165,55.665,38.816,5566
+2001-06-18,null,null,null,null,null,null
+2020-12-04,191.51,192.01,191.01,191.51,191.51,19151
+`, false},
+		{"Layout", testCSVResult(), &CSVOptions{Layout: DateTimeLayout, Location: time.UTC}, `Date,Open,High,Low,Close,Adj Close,Volume
+2001-06-15 13:30:00,55.665,56.165,55.165,55.665,38.816,5566
+2001-06-18 13:30:00,null,null,null,null,null,null
+2020-12-04 14:30:00,191.51,192.01,191.01,191.51,191.51,19151
+`, false},
+		{"Intraday", &Result{
+			Meta:      Meta{DataGranularity: "1m", ExchangeTimezoneName: newYork.String()},
+			Timestamp: []int64{1607092200},
+			Indicators: Indicators{Quote: []Quote{{
+				Volume: nullFloats(100), Close: nullFloats(2), Open: nullFloats(1), High: nullFloats(2), Low: nullFloats(1),
+			}}},
+		}, nil, `Date,Open,High,Low,Close,Adj Close,Volume
+2020-12-04 09:30:00-05:00,1,2,1,2,2,100
+`, false},
+		{"Mismatch", &Result{Timestamp: []int64{1}, Indicators: Indicators{Quote: []Quote{{}}}}, nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			if err := WriteCSV(w, tt.r, tt.opts); (err != nil) != tt.wantErr {
+				t.Errorf("WriteCSV() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := w.String(); got != tt.want {
+				t.Errorf("WriteCSV() = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	est := time.FixedZone("EST", -18000)
+	opts := &CSVOptions{Layout: DateTimeLayout, Location: est}
+
+	// write then read keeps timestamps and values
+	w := &bytes.Buffer{}
+	if err := WriteCSV(w, testCSVResult(), opts); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadCSV(w, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := testCSVResult()
+	if !reflect.DeepEqual(got.Timestamp, want.Timestamp) {
+		t.Errorf("ReadCSV() Timestamp = %v, want %v", got.Timestamp, want.Timestamp)
+	}
+	gotBars, err := got.Bars()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantBars, _ := want.Bars()
+	if len(gotBars) != len(wantBars) {
+		t.Fatalf("ReadCSV() Bars = %v, want %v", gotBars, wantBars)
+	}
+	for i := range gotBars {
+		if !gotBars[i].Time.Equal(wantBars[i].Time) || gotBars[i].Time.Location().String() != "EST" ||
+			gotBars[i].Close != wantBars[i].Close || gotBars[i].AdjClose != wantBars[i].AdjClose || gotBars[i].Volume != wantBars[i].Volume {
+			t.Errorf("ReadCSV() Bar %d = %+v, want %+v", i, gotBars[i], wantBars[i])
+		}
+	}
+
+	// intraday written with the defaults is read back at the same time
+	intraday := &Result{
+		Meta:      Meta{DataGranularity: "5m", ExchangeTimezoneName: "America/New_York"},
+		Timestamp: []int64{1607092200, 1607092500},
+		Indicators: Indicators{Quote: []Quote{{
+			Volume: nullFloats(100, 200), Close: nullFloats(2, 3), Open: nullFloats(1, 2), High: nullFloats(2, 3), Low: nullFloats(1, 2),
+		}}},
+	}
+	w = &bytes.Buffer{}
+	if err := WriteCSV(w, intraday, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = ReadCSV(w, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Timestamp, intraday.Timestamp) {
+		t.Errorf("ReadCSV() intraday Timestamp = %v, want %v", got.Timestamp, intraday.Timestamp)
+	}
+
+	// the offset is the one of the first bar, not of now
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	summer, err := ReadCSV(strings.NewReader("Date,Open,High,Low,Close,Volume\n2020-07-01,1,2,1,2,100\n"), &CSVOptions{Location: newYork})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summer.Meta.Timezone != "EDT" || summer.Meta.Gmtoffset != -14400 {
+		t.Errorf("ReadCSV() Meta = %+v, want EDT -14400", summer.Meta)
+	}
+
+	tests := []struct {
+		name    string
+		csv     string
+		want    []int64
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"NoAdjClose", "\ufeffDate,Open,High,Low,Close,Volume\n2020-12-04,1,2,1,2,100\n", []int64{1607040000}, false},
+		{"Reordered", "Volume,Date,Close,Low,High,Open\n100,12/04/2020,2,1,2,1\n", []int64{1607040000}, false},
+		{"Empty", "Date,Open,High,Low,Close,Adj Close,Volume\n", nil, false},
+		{"MissingColumn", "Date,Open,High,Low,Volume\n2020-12-04,1,2,1,100\n", nil, true},
+		{"BadDate", "Date,Open,High,Low,Close,Volume\n04.12.2020,1,2,1,2,100\n", nil, true},
+		{"BadValue", "Date,Open,High,Low,Close,Volume\n2020-12-04,x,2,1,2,100\n", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV(strings.NewReader(tt.csv), nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadCSV() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got.Timestamp, tt.want) {
+				t.Errorf("ReadCSV() Timestamp = %v, want %v", got.Timestamp, tt.want)
+			}
+		})
+	}
+}
+
+func TestDividendsSplitsCSV(t *testing.T) {
+	r := testCSVResult()
+
+	w := &bytes.Buffer{}
+	if err := WriteDividendsCSV(w, r, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "Date,Dividends\n2001-06-25,0.14\n2020-09-25,0.674\n"; got != want {
+		t.Errorf("WriteDividendsCSV() = \n%v, want \n%v", got, want)
+	}
+	dividends, err := ReadDividendsCSV(w, &CSVOptions{Location: r.Meta.Location()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dividends) != 2 || dividends[0].Amount != 0.14 || dividends[1].Time.Format(DateLayout) != "2020-09-25" {
+		t.Errorf("ReadDividendsCSV() = %v", dividends)
+	}
+
+	w.Reset()
+	if err := WriteSplitsCSV(w, r, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.String(), "Date,Stock Splits\n2008-06-18,2:1\n"; got != want {
+		t.Errorf("WriteSplitsCSV() = \n%v, want \n%v", got, want)
+	}
+	splits, err := ReadSplitsCSV(strings.NewReader("Date,Stock Splits\n2008-06-18,2:1\n2020-08-31,4/1\n"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(splits) != 2 || splits[0].Ratio.Cmp(big.NewRat(2, 1)) != 0 || splits[1].Ratio.Cmp(big.NewRat(4, 1)) != 0 {
+		t.Errorf("ReadSplitsCSV() = %v", splits)
+	}
+	if _, err := ReadSplitsCSV(strings.NewReader("Date,Stock Splits\n2008-06-18,two\n"), nil); err == nil {
+		t.Error("ReadSplitsCSV() invalid ratio error = nil")
+	}
+}
@@ -0,0 +1,225 @@
+package yahoofinance
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Download get history of symbols concurrently
+// Same parameters as Period, use DownloadBetween for a date range instead
+// Duplicate symbols are downloaded once
+func (r *HistoryService) Download(symbols []string, period Range, interval Interval) *DownloadCall {
+	c := r.download(symbols, interval)
+	c.urlParams.Set("range", strings.ToLower(string(period)))
+
+	return c
+}
+
+// DownloadBetween get history of symbols between start and end concurrently
+// Same parameters as Between along with interval
+// Duplicate symbols are downloaded once
+func (r *HistoryService) DownloadBetween(symbols []string, start, end time.Time, interval Interval) *DownloadCall {
+	c := r.download(symbols, interval)
+	c.between = true
+	c.urlParams.Set("period1", strconv.FormatInt(start.Unix(), 10))
+	c.urlParams.Set("period2", strconv.FormatInt(end.Unix(), 10))
+
+	return c
+}
+
+// download call of the unique symbols
+func (r *HistoryService) download(symbols []string, interval Interval) *DownloadCall {
+	c := &DownloadCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		workers: 4,
+	}
+
+	seen := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		if !seen[symbol] {
+			seen[symbol] = true
+			c.symbols = append(c.symbols, symbol)
+		}
+	}
+
+	c.urlParams.Set("interval", strings.ToLower(string(interval)))
+	c.urlParams.Set("includeAdjustedClose", "true")
+	c.urlParams.Set("events", "div,splits")
+
+	return c
+}
+
+// DownloadCall call function
+type DownloadCall struct {
+	DefaultCall
+
+	symbols   []string
+	between   bool
+	workers   int
+	progress  func(DownloadProgress)
+	strict    bool
+	unchecked bool
+}
+
+// DownloadProgress is reported after each symbol finishes
+type DownloadProgress struct {
+	Symbol string
+	Err    error
+	Done   int
+	Total  int
+}
+
+// DownloadResult history and error per symbol
+type DownloadResult struct {
+	Infomations map[string]*Infomation
+	Errors      map[string]error
+}
+
+// IncludeAdjustedClose Adjust Close Default is true
+func (c *DownloadCall) IncludeAdjustedClose(s string) *DownloadCall {
+	c.urlParams.Set("includeAdjustedClose", strings.ToLower(s))
+	return c
+}
+
+// Workers number of concurrent requests, Default is 4
+func (c *DownloadCall) Workers(n int) *DownloadCall {
+	if n < 1 {
+		n = 1
+	}
+	c.workers = n
+	return c
+}
+
+// Progress fn is called after each symbol finishes, never concurrently
+func (c *DownloadCall) Progress(fn func(DownloadProgress)) *DownloadCall {
+	c.progress = fn
+	return c
+}
+
+// Unchecked skips the local validation of interval and range,
+// e.g. for values added by Yahoo after this package
+func (c *DownloadCall) Unchecked() *DownloadCall {
+	c.unchecked = true
+	return c
+}
+
+// Strict rejects results without bars or with mismatched lengths
+// between Timestamp and Indicators
+func (c *DownloadCall) Strict() *DownloadCall {
+	c.strict = true
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *DownloadCall) Context(ctx context.Context) *DownloadCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *DownloadCall) SetHeader(key, value string) *DownloadCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *DownloadCall) Retry(policy *RetryPolicy) *DownloadCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send requests with ctx
+func (c *DownloadCall) DoContext(ctx context.Context) (*DownloadResult, error) {
+	return c.Context(ctx).Do()
+}
+
+// call builds the request of symbol
+func (c *DownloadCall) call(symbol string) interface{ Do() (*Infomation, error) } {
+	dc := DefaultCall{
+		s:         c.s,
+		urlParams: cloneValues(c.urlParams),
+		ctx:       c.ctx,
+		header:    c.header,
+		retry:     c.retry,
+	}
+
+	if c.between {
+		return &BetweenCall{DefaultCall: dc, symbol: symbol, strict: c.strict, unchecked: c.unchecked}
+	}
+	return &PeriodCall{DefaultCall: dc, symbol: symbol, strict: c.strict, unchecked: c.unchecked}
+}
+
+// Do send requests
+// A failed symbol is reported in DownloadResult.Errors without stopping the others,
+// the error is only non-nil if the context is canceled, along with the partial result
+func (c *DownloadCall) Do() (*DownloadResult, error) {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ret := &DownloadResult{
+		Infomations: make(map[string]*Infomation),
+		Errors:      make(map[string]error),
+	}
+
+	var mu sync.Mutex
+	done := 0
+	report := func(symbol string, info *Infomation, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err != nil {
+			ret.Errors[symbol] = err
+		} else {
+			ret.Infomations[symbol] = info
+		}
+		done++
+		if c.progress != nil {
+			c.progress(DownloadProgress{Symbol: symbol, Err: err, Done: done, Total: len(c.symbols)})
+		}
+	}
+
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < c.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for symbol := range jobs {
+				info, err := c.call(symbol).Do()
+				report(symbol, info, err)
+			}
+		}()
+	}
+
+	var pending []string
+	for i, symbol := range c.symbols {
+		select {
+		case jobs <- symbol:
+			continue
+		case <-ctx.Done():
+			pending = c.symbols[i:]
+		}
+		break
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, symbol := range pending {
+		report(symbol, nil, ctx.Err())
+	}
+
+	return ret, ctx.Err()
+}
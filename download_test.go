@@ -0,0 +1,92 @@
+package yahoofinance
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestDownloadCall_Do(t *testing.T) {
+	body := func(symbol string) string {
+		return `{"chart":{"result":[{"meta":{"symbol":"` + symbol + `"},"timestamp":[1607092200],` +
+			`"indicators":{"quote":[{"volume":[100],"close":[2],"open":[1],"high":[2],"low":[1]}]}}],"error":null}}`
+	}
+	client := clientRoutes(map[string]string{
+		"https://query1.finance.yahoo.com/v8/finance/chart/VTI?events=div%2Csplits&includeAdjustedClose=true&interval=1d&range=1mo":                             body("VTI"),
+		"https://query1.finance.yahoo.com/v8/finance/chart/0050.TW?events=div%2Csplits&includeAdjustedClose=true&interval=1d&range=1mo":                         body("0050.TW"),
+		"https://query1.finance.yahoo.com/v8/finance/chart/VTI?events=div%2Csplits&includeAdjustedClose=true&interval=1d&period1=1606780800&period2=1607299200": body("VTI"),
+	})
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name       string
+		c          *DownloadCall
+		wantInfos  []string
+		wantErrors []string
+		wantTotal  int
+	}{
+		// TODO: Add test cases.
+		{"Period", NewHistoryService(yfinanceTest).Download([]string{"VTI", "0050.TW", "0050.W"}, "1mo", "1d").Workers(2),
+			[]string{"0050.TW", "VTI"}, []string{"0050.W"}, 3},
+		{"Between", NewHistoryService(yfinanceTest).DownloadBetween([]string{"VTI", "0050.TW"}, time.Unix(1606780800, 0), time.Unix(1607299200, 0), "1d"),
+			[]string{"VTI"}, []string{"0050.TW"}, 2},
+		{"Duplicates", NewHistoryService(yfinanceTest).Download([]string{"VTI", "0050.TW", "VTI"}, "1mo", "1d").Workers(2),
+			[]string{"0050.TW", "VTI"}, nil, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var progress []int
+			rsp, err := tt.c.Progress(func(p DownloadProgress) {
+				progress = append(progress, p.Done)
+				if p.Total != tt.wantTotal {
+					t.Errorf("DownloadProgress.Total = %d, want %d", p.Total, tt.wantTotal)
+				}
+			}).Do()
+			if err != nil {
+				t.Fatalf("DownloadCall.Do() error = %v", err)
+			}
+
+			var infos, errs []string
+			for symbol, info := range rsp.Infomations {
+				if got := info.Chart.Result[0].Meta.Symbol; got != symbol {
+					t.Errorf("DownloadCall.Do() symbol = %s, want %s", got, symbol)
+				}
+				infos = append(infos, symbol)
+			}
+			for symbol := range rsp.Errors {
+				errs = append(errs, symbol)
+			}
+			sort.Strings(infos)
+			sort.Strings(errs)
+			if !reflect.DeepEqual(infos, tt.wantInfos) {
+				t.Errorf("DownloadCall.Do() Infomations = %v, want %v", infos, tt.wantInfos)
+			}
+			if !reflect.DeepEqual(errs, tt.wantErrors) {
+				t.Errorf("DownloadCall.Do() Errors = %v, want %v", errs, tt.wantErrors)
+			}
+			if len(progress) != tt.wantTotal || progress[len(progress)-1] != tt.wantTotal {
+				t.Errorf("DownloadCall.Do() progress = %v", progress)
+			}
+		})
+	}
+}
+
+func TestDownloadCall_Do_Canceled(t *testing.T) {
+	client := clientTest("", http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	symbols := []string{"VTI", "0050.TW", "AAPL", "MSFT"}
+	rsp, err := NewHistoryService(yfinanceTest).Download(symbols, "1mo", "1d").Workers(1).DoContext(ctx)
+	if err != context.Canceled {
+		t.Errorf("DownloadCall.Do() error = %v, want %v", err, context.Canceled)
+	}
+	if got := len(rsp.Infomations) + len(rsp.Errors); got != len(symbols) {
+		t.Errorf("DownloadCall.Do() reported %d symbols, want %d", got, len(symbols))
+	}
+}
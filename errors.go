@@ -4,40 +4,129 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Sentinel errors matched by errors.Is against *Error
+var (
+	// ErrNotFound symbol not found, e.g. "Not Found: No data found, symbol may be delisted"
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited too many requests
+	ErrRateLimited = errors.New("rate limited")
+	// ErrUnauthorized missing or invalid cookie and crumb
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrInvalidRange range, interval or period not supported by the symbol
+	ErrInvalidRange = errors.New("invalid range")
+	// ErrUnprocessable request understood but not processable
+	ErrUnprocessable = errors.New("unprocessable")
+	// ErrNoData successful response without data, e.g. a null chart result
+	ErrNoData = errors.New("no data")
 )
 
 // Error contains an error response from the server.
 type Error struct {
-	// Code is the HTTP response status code and will always be populated.
+	// Code is the HTTP response status code, 0 only if no response was received.
 	Code int `json:"code,omitempty"`
 	// Message is the server response message and is only populated when
 	// explicitly referenced by the JSON server response.
 	Message string `json:"message,omitempty"`
+	// YahooCode is the error code of Yahoo, e.g. "Not Found" or "Bad Request",
+	// and Description is its description. Both are empty if the body is not JSON.
+	YahooCode   string
+	Description string
 	// Body is the raw response returned by the server.
 	// It is often but not always JSON, depending on how the request fails.
 	Body string
 	// Header contains the response header fields from the server.
 	Header http.Header
+	// Attempts is the number of requests sent when retried, 0 if not retried.
+	Attempts int
+	// Err is the error of the last attempt when no response was received,
+	// e.g. a connection reset.
+	Err error
 }
 
 func (e *Error) Error() string {
-	if e.Message == "" {
-		return fmt.Sprintf("API: got HTTP response code %d with body: %v", e.Code, e.Body)
-	}
 	var buf bytes.Buffer
-	fmt.Fprintf(&buf, "API: Error %d: ", e.Code)
-	if e.Message != "" {
+	if e.Err != nil {
+		fmt.Fprintf(&buf, "API: %v", e.Err)
+	} else if e.Message == "" {
+		fmt.Fprintf(&buf, "API: got HTTP response code %d with body: %v", e.Code, e.Body)
+	} else {
+		fmt.Fprintf(&buf, "API: Error %d: ", e.Code)
 		fmt.Fprintf(&buf, "%s", e.Message)
 	}
+	if e.Attempts > 1 {
+		fmt.Fprintf(&buf, " (after %d attempts)", e.Attempts)
+	}
 
 	return buf.String()
 }
 
+// Unwrap returns Err
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether e matches one of the sentinel errors
+func (e *Error) Is(target error) bool {
+	code := strings.ToLower(e.YahooCode)
+	switch target {
+	case ErrNotFound:
+		return e.Code == http.StatusNotFound || code == "not found"
+	case ErrRateLimited:
+		return e.Code == http.StatusTooManyRequests || code == "too many requests"
+	case ErrUnauthorized:
+		return e.Code == http.StatusUnauthorized || e.Code == http.StatusForbidden ||
+			code == "unauthorized" || code == "forbidden"
+	case ErrInvalidRange:
+		if e.Code != http.StatusBadRequest && e.Code != http.StatusUnprocessableEntity &&
+			code != "bad request" && code != "unprocessable entity" {
+			return false
+		}
+		desc := strings.ToLower(e.Description)
+		for _, s := range []string{"range", "interval", "starttime", "endtime", "period"} {
+			if strings.Contains(desc, s) {
+				return true
+			}
+		}
+		return false
+	case ErrUnprocessable:
+		return e.Code == http.StatusUnprocessableEntity || code == "unprocessable entity"
+	}
+
+	return false
+}
+
 type errorReply struct {
 	Chart struct {
-		Error struct {
-			Code        string `json:"code"`
-			Description string `json:"description"`
-		} `json:"error"`
+		Error ErrorHistory `json:"error"`
 	} `json:"chart"`
+	QuoteResponse struct {
+		Error ErrorHistory `json:"error"`
+	} `json:"quoteResponse"`
+	QuoteSummary struct {
+		Error ErrorHistory `json:"error"`
+	} `json:"quoteSummary"`
+	Timeseries struct {
+		Error ErrorHistory `json:"error"`
+	} `json:"timeseries"`
+	OptionChain struct {
+		Error ErrorHistory `json:"error"`
+	} `json:"optionChain"`
+	Finance struct {
+		Error ErrorHistory `json:"error"`
+	} `json:"finance"`
+}
+
+// error returns the first error reported by any endpoint
+func (r *errorReply) error() ErrorHistory {
+	for _, e := range []ErrorHistory{r.Chart.Error, r.QuoteResponse.Error, r.QuoteSummary.Error, r.Timeseries.Error, r.OptionChain.Error, r.Finance.Error} {
+		if e.Code != "" || e.Description != "" {
+			return e
+		}
+	}
+	return ErrorHistory{}
 }
@@ -0,0 +1,49 @@
+package yahoofinance
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestError_Is(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		statusCode int
+		target     error
+		want       bool
+	}{
+		// TODO: Add test cases.
+		{"NotFound", `{"chart":{"result":null,"error":{"code":"Not Found","description":"No data found, symbol may be delisted"}}}`, http.StatusNotFound, ErrNotFound, true},
+		{"NotFoundCode", `{"quoteSummary":{"result":null,"error":{"code":"Not Found","description":"Quote not found for ticker symbol: XXXX"}}}`, http.StatusBadRequest, ErrNotFound, true},
+		{"RateLimited", `Too Many Requests`, http.StatusTooManyRequests, ErrRateLimited, true},
+		{"Unauthorized", `{"finance":{"result":null,"error":{"code":"Unauthorized","description":"Invalid Crumb"}}}`, http.StatusUnauthorized, ErrUnauthorized, true},
+		{"InvalidRange", `{"chart":{"result":null,"error":{"code":"Unprocessable Entity","description":"1m data not available for startTime=1609459200 and endTime=1612137600. The requested range must be within the last 30 days."}}}`, http.StatusUnprocessableEntity, ErrInvalidRange, true},
+		{"InvalidInterval", `{"chart":{"result":null,"error":{"code":"Bad Request","description":"Invalid input - interval=2h is not supported. Valid intervals: [1m, 2m, 5m, 15m, 30m, 60m, 90m, 1h, 1d, 5d, 1wk, 1mo, 3mo]"}}}`, http.StatusBadRequest, ErrInvalidRange, true},
+		{"Unprocessable", `{"chart":{"result":null,"error":{"code":"Unprocessable Entity","description":"1m data not available for startTime=1609459200 and endTime=1612137600."}}}`, http.StatusUnprocessableEntity, ErrUnprocessable, true},
+		{"BadRequest", `{"finance":{"result":null,"error":{"code":"Bad Request","description":"Missing value for the \"symbols\" argument"}}}`, http.StatusBadRequest, ErrInvalidRange, false},
+		{"Other", `<html></html>`, http.StatusInternalServerError, ErrNotFound, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			yfinanceTest, _ := New(clientTest(tt.body, tt.statusCode))
+			_, err := yfinanceTest.History.Period("XXXX", "1mo", "1d").Do()
+			if err == nil {
+				t.Fatal("PeriodCall.Do() error = nil")
+			}
+			if got := errors.Is(err, tt.target); got != tt.want {
+				t.Errorf("errors.Is(%v, %v) = %v, want %v", err, tt.target, got, tt.want)
+			}
+
+			var e *Error
+			if !errors.As(err, &e) {
+				t.Fatalf("errors.As(%v) = false, want *Error", err)
+			}
+			if e.Code != tt.statusCode {
+				t.Errorf("Error.Code = %v, want %v", e.Code, tt.statusCode)
+			}
+		})
+	}
+}
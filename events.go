@@ -0,0 +1,174 @@
+package yahoofinance
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DividendEvent cash dividend paid per share
+type DividendEvent struct {
+	// Time is the ex-dividend date in the exchange's location.
+	Time   time.Time
+	Amount float64
+}
+
+// SplitEvent stock split, e.g. Ratio 2/1 for a 2:1 split
+type SplitEvent struct {
+	// Time is the effective date in the exchange's location.
+	Time time.Time
+	// Ratio is new shares per old share, Numerator/Denominator of Yahoo.
+	Ratio *big.Rat
+}
+
+// Dividends dividends sorted by time
+func (r *Result) Dividends() []DividendEvent {
+	loc := r.Meta.Location()
+	events := make([]DividendEvent, 0, len(r.Events.Dividends))
+	for _, d := range r.Events.Dividends {
+		events = append(events, DividendEvent{
+			Time:   time.Unix(d.Date, 0).In(loc),
+			Amount: d.Amount,
+		})
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+
+	return events
+}
+
+// Splits splits sorted by time
+// A split without a valid ratio is skipped
+func (r *Result) Splits() []SplitEvent {
+	loc := r.Meta.Location()
+	events := make([]SplitEvent, 0, len(r.Events.Splits))
+	for _, s := range r.Events.Splits {
+		ratio, ok := s.ratio()
+		if !ok {
+			continue
+		}
+		events = append(events, SplitEvent{
+			Time:  time.Unix(s.Date, 0).In(loc),
+			Ratio: ratio,
+		})
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+
+	return events
+}
+
+// ratio Numerator/Denominator, falling back to SplitRatio, e.g. "2:1"
+func (s *Split) ratio() (*big.Rat, bool) {
+	if s.Numerator > 0 && s.Denominator > 0 {
+		return big.NewRat(int64(s.Numerator), int64(s.Denominator)), true
+	}
+
+	ratio, ok := new(big.Rat).SetString(strings.Replace(s.SplitRatio, ":", "/", 1))
+	if !ok || ratio.Sign() <= 0 {
+		return nil, false
+	}
+	return ratio, true
+}
+
+// Dividends dividends of the first result
+func (info *Infomation) Dividends() ([]DividendEvent, error) {
+	if len(info.Chart.Result) == 0 {
+		return nil, errors.New("no result")
+	}
+
+	return info.Chart.Result[0].Dividends(), nil
+}
+
+// Splits splits of the first result
+func (info *Infomation) Splits() ([]SplitEvent, error) {
+	if len(info.Chart.Result) == 0 {
+		return nil, errors.New("no result")
+	}
+
+	return info.Chart.Result[0].Splits(), nil
+}
+
+// Actions get all dividends and splits of symbol
+// https://query1.finance.yahoo.com/v8/finance/chart/VTI?range=max&interval=3mo&events=div,splits
+func (r *HistoryService) Actions(symbol string) *ActionsCall {
+	c := &ActionsCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+
+	// the coarsest interval keeps the quotes sent along with events small
+	c.urlParams.Set("range", string(RangeMax))
+	c.urlParams.Set("interval", string(Interval3mo))
+	c.urlParams.Set("includeAdjustedClose", "false")
+	c.urlParams.Set("events", "div,splits")
+
+	return c
+}
+
+// ActionsCall call function
+type ActionsCall struct {
+	DefaultCall
+
+	symbol string
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *ActionsCall) Context(ctx context.Context) *ActionsCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *ActionsCall) SetHeader(key, value string) *ActionsCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *ActionsCall) Retry(policy *RetryPolicy) *ActionsCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send request with ctx
+func (c *ActionsCall) DoContext(ctx context.Context) (*ActionsInfomation, error) {
+	return c.Context(ctx).Do()
+}
+
+func (c *ActionsCall) doRequest() (*http.Response, error) {
+	return c.execute("/v8/finance/chart", c.symbol)
+}
+
+// Do send request
+func (c *ActionsCall) Do() (*ActionsInfomation, error) {
+	res, err := c.doRequest()
+	info := &Infomation{}
+	if info.ServerResponse, err = readResponse(info, res, err); err != nil {
+		return nil, err
+	}
+	if err := info.validate(false); err != nil {
+		return nil, err
+	}
+
+	ret := &ActionsInfomation{ServerResponse: info.ServerResponse}
+	if len(info.Chart.Result) > 0 {
+		r := &info.Chart.Result[0]
+		ret.Symbol = r.Meta.Symbol
+		ret.Dividends = r.Dividends()
+		ret.Splits = r.Splits()
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,102 @@
+package yahoofinance
+
+import (
+	"math/big"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestResult_Dividends(t *testing.T) {
+	loc := time.FixedZone("EST", -18000)
+	meta := Meta{Timezone: "EST", Gmtoffset: -18000}
+
+	tests := []struct {
+		name string
+		r    *Result
+		want []DividendEvent
+	}{
+		// TODO: Add test cases.
+		{"Test", &Result{Meta: meta, Events: Events{Dividends: map[string]Dividend{
+			"1601040600": {Amount: 0.674, Date: 1601040600},
+			"993475800":  {Amount: 0.14, Date: 993475800},
+			"1300000000": {Amount: 0.3, Date: 1300000000},
+		}}}, []DividendEvent{
+			{Time: time.Unix(993475800, 0).In(loc), Amount: 0.14},
+			{Time: time.Unix(1300000000, 0).In(loc), Amount: 0.3},
+			{Time: time.Unix(1601040600, 0).In(loc), Amount: 0.674},
+		}},
+		{"Empty", &Result{Meta: meta}, []DividendEvent{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Dividends(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Result.Dividends() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Splits(t *testing.T) {
+	loc := time.FixedZone("EST", -18000)
+	meta := Meta{Timezone: "EST", Gmtoffset: -18000}
+
+	tests := []struct {
+		name string
+		r    *Result
+		want []SplitEvent
+	}{
+		// TODO: Add test cases.
+		{"Test", &Result{Meta: meta, Events: Events{Splits: map[string]Split{
+			"1598880600": {Date: 1598880600, Numerator: 4, Denominator: 1, SplitRatio: "4:1"},
+			"1213795800": {Date: 1213795800, Numerator: 2, Denominator: 1, SplitRatio: "2:1"},
+			"1300000000": {Date: 1300000000, SplitRatio: "1:10"},
+			"1400000000": {Date: 1400000000},
+		}}}, []SplitEvent{
+			{Time: time.Unix(1213795800, 0).In(loc), Ratio: big.NewRat(2, 1)},
+			{Time: time.Unix(1300000000, 0).In(loc), Ratio: big.NewRat(1, 10)},
+			{Time: time.Unix(1598880600, 0).In(loc), Ratio: big.NewRat(4, 1)},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.Splits()
+			if len(got) != len(tt.want) {
+				t.Fatalf("Result.Splits() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Time.Equal(tt.want[i].Time) || got[i].Ratio.Cmp(tt.want[i].Ratio) != 0 {
+					t.Errorf("Result.Splits()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestActionsCall_Do(t *testing.T) {
+	client := clientTest(`{"chart":{"result":[{"meta":{"symbol":"VTI","timezone":"EST","gmtoffset":-18000},
+		"events":{"dividends":{"1601040600":{"amount":0.674,"date":1601040600},"993475800":{"amount":0.14,"date":993475800}},
+		"splits":{"1213795800":{"date":1213795800,"numerator":2,"denominator":1,"splitRatio":"2:1"}}}}],"error":null}}`, http.StatusOK)
+	yfinanceTest, _ := New(client)
+	loc := time.FixedZone("EST", -18000)
+
+	c := yfinanceTest.History.Actions("VTI")
+	if got, want := c.urlParams.Encode(), "events=div%2Csplits&includeAdjustedClose=false&interval=3mo&range=max"; got != want {
+		t.Errorf("HistoryService.Actions() params = %v, want %v", got, want)
+	}
+	got, err := c.Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []DividendEvent{
+		{Time: time.Unix(993475800, 0).In(loc), Amount: 0.14},
+		{Time: time.Unix(1601040600, 0).In(loc), Amount: 0.674},
+	}
+	if got.Symbol != "VTI" || !reflect.DeepEqual(got.Dividends, want) {
+		t.Errorf("ActionsCall.Do() = %+v, want dividends %v", got, want)
+	}
+	if len(got.Splits) != 1 || got.Splits[0].Ratio.Cmp(big.NewRat(2, 1)) != 0 {
+		t.Errorf("ActionsCall.Do() splits = %v, want 2:1", got.Splits)
+	}
+}
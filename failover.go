@@ -0,0 +1,180 @@
+package yahoofinance
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// healthDecay weights the last result against the history of a host
+	healthDecay = 0.7
+	// healthRecovery is how long a failed host takes to be fully trusted again
+	healthRecovery = time.Minute
+)
+
+// hostState health of one base host
+type hostState struct {
+	base   *url.URL
+	score  float64 // exponentially weighted success rate, 1 is healthy
+	failed time.Time
+}
+
+// health score of h at now, which recovers toward 1 since the last failure
+func (h *hostState) health(now time.Time) float64 {
+	if h.score >= 1 {
+		return 1
+	}
+	w := float64(now.Sub(h.failed)) / float64(healthRecovery)
+	if w > 1 {
+		w = 1
+	}
+	return h.score + (1-h.score)*w
+}
+
+// hostPool base hosts of failover
+type hostPool struct {
+	mu    sync.Mutex
+	hosts []*hostState
+}
+
+// newHostPool parses hosts, the first one is preferred while all are healthy
+func newHostPool(hosts ...string) (*hostPool, error) {
+	if len(hosts) == 0 {
+		return nil, errors.New("no host")
+	}
+
+	p := &hostPool{}
+	for _, host := range hosts {
+		u, err := url.Parse(host)
+		if err != nil {
+			return nil, errors.Wrapf(err, "url.Parse %q", host)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return nil, errors.Errorf("host %q is not absolute", host)
+		}
+		p.hosts = append(p.hosts, &hostState{base: u, score: 1})
+	}
+
+	return p, nil
+}
+
+// ordered returns hosts from the healthiest, ties keep the given order
+func (p *hostPool) ordered() []*hostState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	hosts := append([]*hostState(nil), p.hosts...)
+	health := make(map[*hostState]float64, len(hosts))
+	for _, h := range hosts {
+		health[h] = h.health(now)
+	}
+	sort.SliceStable(hosts, func(i, j int) bool {
+		return health[hosts[i]] > health[hosts[j]]
+	})
+
+	return hosts
+}
+
+// report records the result of a request to h
+func (p *hostPool) report(h *hostState, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	score := h.health(now) * healthDecay
+	if ok {
+		score += 1 - healthDecay
+	} else {
+		h.failed = now
+	}
+	h.score = score
+}
+
+// health returns the health score of each host
+func (p *hostPool) health() map[string]float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	m := make(map[string]float64, len(p.hosts))
+	for _, h := range p.hosts {
+		m[h.base.String()] = h.health(now)
+	}
+	return m
+}
+
+// rebase moves u from base to the host of to, keeping the path relative to base
+func rebase(u, base, to *url.URL) *url.URL {
+	c := *u
+	c.Scheme = to.Scheme
+	c.Host = to.Host
+	rel := strings.TrimPrefix(u.Path, strings.TrimSuffix(base.Path, "/"))
+	c.Path = strings.TrimSuffix(to.Path, "/") + rel
+	c.RawPath = ""
+
+	return &c
+}
+
+// failover middleware sends req to the healthiest host first and
+// tries the next one on connection errors or 5xx responses
+// req must be built on the first host of pool
+func failover(pool *hostPool) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			base := pool.hosts[0].base
+			hosts := pool.ordered()
+
+			var res *http.Response
+			var err error
+			for i, h := range hosts {
+				r := req.Clone(req.Context())
+				r.URL = rebase(req.URL, base, h.base)
+				r.Host = ""
+
+				res, err = next(r)
+				if err != nil && req.Context().Err() != nil {
+					return res, err
+				}
+				ok := err == nil && res.StatusCode < http.StatusInternalServerError
+				pool.report(h, ok)
+				if ok {
+					return res, nil
+				}
+				if res != nil && i < len(hosts)-1 {
+					res.Body.Close()
+				}
+			}
+
+			return res, err
+		}
+	}
+}
+
+// SetHosts fails over between the base hosts in order,
+// e.g. https://query1.finance.yahoo.com/ and https://query2.finance.yahoo.com/
+// Each host keeps a health score so the healthy one is preferred for subsequent calls
+func (s *Service) SetHosts(hosts ...string) error {
+	pool, err := newHostPool(hosts...)
+	if err != nil {
+		return errors.Wrapf(err, "newHostPool")
+	}
+	s.host = hosts[0]
+	s.hosts = pool
+
+	return nil
+}
+
+// HostHealth returns the health score between 0 and 1 of each host set by SetHosts
+func (s *Service) HostHealth() map[string]float64 {
+	if s.hosts == nil {
+		return nil
+	}
+	return s.hosts.health()
+}
@@ -0,0 +1,129 @@
+package yahoofinance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// hostServer records request paths and replies statusCode
+type hostServer struct {
+	*httptest.Server
+	statusCode int
+	paths      []string
+}
+
+func newHostServer(statusCode int) *hostServer {
+	h := &hostServer{statusCode: statusCode}
+	h.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.paths = append(h.paths, r.URL.Path)
+		w.WriteHeader(h.statusCode)
+		w.Write([]byte(`{"chart":{"result":[{"meta":{"symbol":"VTI"}}],"error":null}}`))
+	}))
+	return h
+}
+
+func TestService_SetHosts(t *testing.T) {
+	down := newHostServer(http.StatusOK)
+	down.Close()
+
+	tests := []struct {
+		name      string
+		primary   *hostServer
+		secondary *hostServer
+		wantFirst []int // requests of primary and secondary after first call
+		wantNext  []int // requests of primary and secondary after second call
+		wantErr   bool
+	}{
+		// TODO: Add test cases.
+		{"Healthy", newHostServer(http.StatusOK), newHostServer(http.StatusOK), []int{1, 0}, []int{2, 0}, false},
+		{"5xx", newHostServer(http.StatusServiceUnavailable), newHostServer(http.StatusOK), []int{1, 1}, []int{1, 2}, false},
+		{"ConnectionError", down, newHostServer(http.StatusOK), []int{0, 1}, []int{0, 2}, false},
+		{"AllDown", newHostServer(http.StatusBadGateway), newHostServer(http.StatusServiceUnavailable), []int{1, 1}, []int{2, 2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer tt.primary.Close()
+			defer tt.secondary.Close()
+
+			yfinanceTest, err := New(GetClient(), WithHosts(tt.primary.URL+"/", tt.secondary.URL+"/"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i, want := range [][]int{tt.wantFirst, tt.wantNext} {
+				_, err := yfinanceTest.History.Period("VTI", "1mo", "1d").Do()
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("call %d error = %v, wantErr %v", i, err, tt.wantErr)
+				}
+				if got := []int{len(tt.primary.paths), len(tt.secondary.paths)}; !reflect.DeepEqual(got, want) {
+					t.Errorf("call %d requests = %v, want %v", i, got, want)
+				}
+			}
+			for _, p := range append(tt.primary.paths, tt.secondary.paths...) {
+				if p != "/v8/finance/chart/VTI" {
+					t.Errorf("path = %v, want /v8/finance/chart/VTI", p)
+				}
+			}
+
+			health := yfinanceTest.HostHealth()
+			if tt.wantFirst[1] > 0 && !tt.wantErr && health[tt.secondary.URL+"/"] <= health[tt.primary.URL+"/"] {
+				t.Errorf("HostHealth() = %v, want secondary healthier", health)
+			}
+		})
+	}
+}
+
+func TestNewHostPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		hosts   []string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", []string{HOST, "https://query2.finance.yahoo.com/"}, false},
+		{"Empty", nil, true},
+		{"Relative", []string{"query2.finance.yahoo.com"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newHostPool(tt.hosts...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newHostPool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_rebase(t *testing.T) {
+	parse := func(s string) *url.URL {
+		u, err := url.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return u
+	}
+
+	tests := []struct {
+		name string
+		u    string
+		base string
+		to   string
+		want string
+	}{
+		// TODO: Add test cases.
+		{"Host", "https://query1.finance.yahoo.com/v8/finance/chart/VTI?range=1mo", "https://query1.finance.yahoo.com/", "https://query2.finance.yahoo.com/",
+			"https://query2.finance.yahoo.com/v8/finance/chart/VTI?range=1mo"},
+		{"Path", "http://127.0.0.1/a/v8/finance/chart/VTI", "http://127.0.0.1/a/", "http://127.0.0.2:8080/b",
+			"http://127.0.0.2:8080/b/v8/finance/chart/VTI"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rebase(parse(tt.u), parse(tt.base), parse(tt.to)).String(); got != tt.want {
+				t.Errorf("rebase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
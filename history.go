@@ -1,11 +1,12 @@
 package yahoofinance
 
 import (
-	"io"
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,10 +28,10 @@ type HistoryService struct {
 // https://query1.finance.yahoo.com/v8/finance/chart/VTI?period1=-2208988800&period2=1607299200&interval=1d&includeAdjustedClose=true&events="div,splits"
 /*
    :Parameters:
-       period : str
+       period : Range
            Valid periods: 1d,5d,1mo,3mo,6mo,1y,2y,5y,10y,ytd,max
            Either Use period parameter or use start and end
-       interval : str
+       interval : Interval
            Valid intervals: 1m,2m,5m,15m,30m,60m,90m,1h,1d,5d,1wk,1mo,3mo
            Intraday data cannot extend last 60 days
        start: str
@@ -40,7 +41,7 @@ type HistoryService struct {
            Download end date string (YYYY-MM-DD) or _datetime.
            Default is now
 */
-func (r *HistoryService) Period(symbol, period, interval string) *PeriodCall {
+func (r *HistoryService) Period(symbol string, period Range, interval Interval) *PeriodCall {
 	c := &PeriodCall{
 		DefaultCall: DefaultCall{
 			s:         r.s,
@@ -50,8 +51,8 @@ func (r *HistoryService) Period(symbol, period, interval string) *PeriodCall {
 		symbol: symbol,
 	}
 
-	c.urlParams.Set("range", strings.ToLower(period))
-	c.urlParams.Set("interval", strings.ToLower(interval))
+	c.urlParams.Set("range", strings.ToLower(string(period)))
+	c.urlParams.Set("interval", strings.ToLower(string(interval)))
 	c.urlParams.Set("includeAdjustedClose", "true")
 	c.urlParams.Set("events", "div,splits")
 
@@ -62,7 +63,9 @@ func (r *HistoryService) Period(symbol, period, interval string) *PeriodCall {
 type PeriodCall struct {
 	DefaultCall
 
-	symbol string
+	symbol    string
+	strict    bool
+	unchecked bool
 }
 
 // IncludeAdjustedClose Adjust Close Default is true
@@ -71,58 +74,66 @@ func (c *PeriodCall) IncludeAdjustedClose(s string) *PeriodCall {
 	return c
 }
 
-func (c *PeriodCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-	reqHeaders.Set("Accept", "application/json, text/javascript, */*; q=0.01")
-	// 無需設定 http.Transport 已自帶，並自動解碼，若加上會產生亂碼
-	// reqHeaders.Set("Accept-Encoding", "gzip, deflate, br")
-	reqHeaders.Set("Accept-Language", "zh-TW,zh;q=0.9,en-US;q=0.8,en;q=0.7")
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.host, "/v8/finance/chart", c.symbol)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
+// Unchecked skips the local validation of interval and range,
+// e.g. for values added by Yahoo after this package
+func (c *PeriodCall) Unchecked() *PeriodCall {
+	c.unchecked = true
+	return c
+}
+
+// Strict rejects results without bars or with mismatched lengths
+// between Timestamp and Indicators
+func (c *PeriodCall) Strict() *PeriodCall {
+	c.strict = true
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *PeriodCall) Context(ctx context.Context) *PeriodCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *PeriodCall) SetHeader(key, value string) *PeriodCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *PeriodCall) Retry(policy *RetryPolicy) *PeriodCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send request with ctx
+func (c *PeriodCall) DoContext(ctx context.Context) (*Infomation, error) {
+	return c.Context(ctx).Do()
+}
 
-	return SendRequest(c.ctx, c.s.client, req)
+func (c *PeriodCall) doRequest() (*http.Response, error) {
+	return c.execute("/v8/finance/chart", c.symbol)
 }
 
 // Do send request
+// The range and interval are validated first unless Unchecked
 func (c *PeriodCall) Do() (*Infomation, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
+	if !c.unchecked {
+		if err := ValidateRange(Range(c.urlParams.Get("range")), Interval(c.urlParams.Get("interval"))); err != nil {
+			return nil, errors.Wrapf(err, "ValidateRange")
 		}
 	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
-	}
 
-	ret := &Infomation{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
+	res, err := c.doRequest()
+	ret := &Infomation{}
+	if ret.ServerResponse, err = readResponse(ret, res, err); err != nil {
+		return nil, err
 	}
-	target := &ret
-	if err := DecodeResponse(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponse")
+	if err := ret.validate(c.strict); err != nil {
+		return nil, err
 	}
 
 	return ret, nil
@@ -133,10 +144,10 @@ func (c *PeriodCall) Do() (*Infomation, error) {
 // https://query1.finance.yahoo.com/v8/finance/chart/VTI?period1=-2208988800&period2=1607299200&interval=1d&includeAdjustedClose=true&events="div,splits"
 /*
    :Parameters:
-       period : str
+       period : Range
            Valid periods: 1d,5d,1mo,3mo,6mo,1y,2y,5y,10y,ytd,max
            Either Use period parameter or use start and end
-       interval : str
+       interval : Interval
            Valid intervals: 1m,2m,5m,15m,30m,60m,90m,1h,1d,5d,1wk,1mo,3mo
            Intraday data cannot extend last 60 days
        start: str
@@ -169,14 +180,17 @@ func (r *HistoryService) Between(symbol string, start, end time.Time) *BetweenCa
 type BetweenCall struct {
 	DefaultCall
 
-	symbol string
+	symbol      string
+	strict      bool
+	unchecked   bool
+	concurrency int
 }
 
 // Interval Default is 1d
 // Valid intervals: 1m,2m,5m,15m,30m,60m,90m,1h,1d,5d,1wk,1mo,3mo
 // Intraday data cannot extend last 60 days
-func (c *BetweenCall) Interval(s string) *BetweenCall {
-	c.urlParams.Set("interval", strings.ToLower(s))
+func (c *BetweenCall) Interval(i Interval) *BetweenCall {
+	c.urlParams.Set("interval", strings.ToLower(string(i)))
 	return c
 }
 
@@ -186,58 +200,127 @@ func (c *BetweenCall) IncludeAdjustedClose(s string) *BetweenCall {
 	return c
 }
 
-func (c *BetweenCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-	reqHeaders.Set("Accept", "application/json, text/javascript, */*; q=0.01")
-	// 無需設定 http.Transport 已自帶，並自動解碼，若加上會產生亂碼
-	// reqHeaders.Set("Accept-Encoding", "gzip, deflate, br")
-	reqHeaders.Set("Accept-Language", "zh-TW,zh;q=0.9,en-US;q=0.8,en;q=0.7")
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.host, "/v8/finance/chart", c.symbol)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
+// Concurrency number of concurrent requests when the range is split, Default is 1
+func (c *BetweenCall) Concurrency(n int) *BetweenCall {
+	if n < 1 {
+		n = 1
 	}
-	req.Header = reqHeaders
+	c.concurrency = n
+	return c
+}
+
+// Unchecked skips the local validation of interval and range,
+// e.g. for values added by Yahoo after this package
+func (c *BetweenCall) Unchecked() *BetweenCall {
+	c.unchecked = true
+	return c
+}
+
+// Strict rejects results without bars or with mismatched lengths
+// between Timestamp and Indicators
+func (c *BetweenCall) Strict() *BetweenCall {
+	c.strict = true
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *BetweenCall) Context(ctx context.Context) *BetweenCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *BetweenCall) SetHeader(key, value string) *BetweenCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *BetweenCall) Retry(policy *RetryPolicy) *BetweenCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send request with ctx
+func (c *BetweenCall) DoContext(ctx context.Context) (*Infomation, error) {
+	return c.Context(ctx).Do()
+}
 
-	return SendRequest(c.ctx, c.s.client, req)
+func (c *BetweenCall) doRequest() (*http.Response, error) {
+	return c.execute("/v8/finance/chart", c.symbol)
 }
 
 // Do send request
+// The interval and range are validated first unless Unchecked, a range beyond the per request
+// limit of an intraday interval is split into chunks and stitched back
 func (c *BetweenCall) Do() (*Infomation, error) {
-	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
+	chunks, err := c.chunks()
+	if err != nil {
+		return nil, errors.Wrapf(err, "chunks")
+	}
+	if len(chunks) == 1 {
+		return c.do(c.strict)
+	}
+
+	infos := make([]*Infomation, len(chunks))
+	errs := make([]error, len(chunks))
+	workers := c.concurrency
+	if workers < 1 {
+		workers = 1
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				infos[j], errs[j] = chunks[j].do(false)
+			}
+		}()
+	}
+	for i := range chunks {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	results := make([]*Result, len(chunks))
+	for i, err := range errs {
+		if err != nil {
+			return nil, errors.Wrapf(err, "chunk %s", chunks[i].urlParams.Get("period1"))
 		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
+		if len(infos[i].Chart.Result) == 0 {
+			results[i] = &Result{}
+			continue
 		}
+		results[i] = &infos[i].Chart.Result[0]
 	}
+	merged, err := mergeResults(results...)
 	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
+		return nil, errors.Wrapf(err, "mergeResults")
 	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
+
+	ret := infos[len(infos)-1]
+	ret.Chart.Result = []Result{*merged}
+	if err := ret.validate(c.strict); err != nil {
+		return nil, err
 	}
 
-	ret := &Infomation{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
-		},
+	return ret, nil
+}
+
+func (c *BetweenCall) do(strict bool) (*Infomation, error) {
+	res, err := c.doRequest()
+	ret := &Infomation{}
+	if ret.ServerResponse, err = readResponse(ret, res, err); err != nil {
+		return nil, err
 	}
-	target := &ret
-	if err := DecodeResponse(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponse")
+	if err := ret.validate(strict); err != nil {
+		return nil, err
 	}
 
 	return ret, nil
@@ -1,10 +1,14 @@
 package yahoofinance
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 func TestPeriodCall_doRequest(t *testing.T) {
@@ -212,15 +216,15 @@ func TestPeriodCall_Do(t *testing.T) {
 						Indicators: Indicators{
 							Quote: []Quote{
 								{
-									Volume: []float64{1067400, 282600, 4401400},
-									Close:  []float64{55.665000915527344, 55.310001373291016, 191.50999450683594},
-									Open:   []float64{55.42499923706055, 55.814998626708984, 190},
-									High:   []float64{56.005001068115234, 55.915000915527344, 191.50999450683594},
-									Low:    []float64{55.17499923706055, 55.310001373291016, 189.99000549316406},
+									Volume: nullFloats(1067400, 282600, 4401400),
+									Close:  nullFloats(55.665000915527344, 55.310001373291016, 191.50999450683594),
+									Open:   nullFloats(55.42499923706055, 55.814998626708984, 190),
+									High:   nullFloats(56.005001068115234, 55.915000915527344, 191.50999450683594),
+									Low:    nullFloats(55.17499923706055, 55.310001373291016, 189.99000549316406),
 								},
 							},
 							Adjclose: []Adjclose{
-								{[]float64{38.816429138183594, 38.568904876708984, 191.50999450683594}},
+								{nullFloats(38.816429138183594, 38.568904876708984, 191.50999450683594)},
 							},
 						},
 					}},
@@ -448,15 +452,15 @@ func TestBetweenCall_Do(t *testing.T) {
 						Indicators: Indicators{
 							Quote: []Quote{
 								{
-									Volume: []float64{1067400, 282600, 4401400},
-									Close:  []float64{55.665000915527344, 55.310001373291016, 191.50999450683594},
-									Open:   []float64{55.42499923706055, 55.814998626708984, 190},
-									High:   []float64{56.005001068115234, 55.915000915527344, 191.50999450683594},
-									Low:    []float64{55.17499923706055, 55.310001373291016, 189.99000549316406},
+									Volume: nullFloats(1067400, 282600, 4401400),
+									Close:  nullFloats(55.665000915527344, 55.310001373291016, 191.50999450683594),
+									Open:   nullFloats(55.42499923706055, 55.814998626708984, 190),
+									High:   nullFloats(56.005001068115234, 55.915000915527344, 191.50999450683594),
+									Low:    nullFloats(55.17499923706055, 55.310001373291016, 189.99000549316406),
 								},
 							},
 							Adjclose: []Adjclose{
-								{[]float64{38.816429138183594, 38.568904876708984, 191.50999450683594}},
+								{nullFloats(38.816429138183594, 38.568904876708984, 191.50999450683594)},
 							},
 						},
 					}},
@@ -478,3 +482,66 @@ func TestBetweenCall_Do(t *testing.T) {
 		})
 	}
 }
+
+func TestPeriodCall_DoContext(t *testing.T) {
+	var got http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+		w.Write([]byte(`{"chart":{"result":[{"meta":{"symbol":"VTI"}}],"error":null}}`))
+	}))
+	defer ts.Close()
+
+	yfinanceTest, _ := New(GetClient())
+	yfinanceTest.host = ts.URL
+
+	_, err := NewHistoryService(yfinanceTest).Period("VTI", "1mo", "1d").SetHeader("X-Test", "1").DoContext(context.Background())
+	if err != nil {
+		t.Fatalf("PeriodCall.DoContext() error = %v", err)
+	}
+	if got.Get("X-Test") != "1" {
+		t.Errorf("PeriodCall.SetHeader() header = %v, want X-Test: 1", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = NewHistoryService(yfinanceTest).Period("VTI", "1mo", "1d").DoContext(ctx)
+	if errors.Cause(err) != context.Canceled {
+		t.Errorf("PeriodCall.DoContext() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestPeriodCall_Do_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		strict  bool
+		wantErr bool
+		wantIs  error
+	}{
+		// TODO: Add test cases.
+		{"EmbeddedError", `{"chart":{"result":null,"error":{"code":"Not Found","description":"No data found, symbol may be delisted"}}}`, false, true, ErrNotFound},
+		{"NullResult", `{"chart":{"result":null,"error":null}}`, false, true, ErrNoData},
+		{"EmptyResult", `{"chart":{"result":[],"error":null}}`, false, true, ErrNoData},
+		{"EmptyResultStrict", `{"chart":{"result":[],"error":null}}`, true, true, ErrNoData},
+		{"NoTimestamp", `{"chart":{"result":[{"meta":{"symbol":"VTI"},"indicators":{"quote":[{}]}}],"error":null}}`, false, false, nil},
+		{"NoTimestampStrict", `{"chart":{"result":[{"meta":{"symbol":"VTI"},"indicators":{"quote":[{}]}}],"error":null}}`, true, true, ErrNoData},
+		{"MismatchStrict", `{"chart":{"result":[{"meta":{"symbol":"VTI"},"timestamp":[1,2],"indicators":{"quote":[{"open":[1],"high":[1],"low":[1],"close":[1],"volume":[1]}]}}],"error":null}}`, true, true, nil},
+		{"Strict", `{"chart":{"result":[{"meta":{"symbol":"VTI"},"timestamp":[1],"indicators":{"quote":[{"open":[1],"high":[1],"low":[1],"close":[1],"volume":[1]}]}}],"error":null}}`, true, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			yfinanceTest, _ := New(clientTest(tt.body, http.StatusOK))
+			call := yfinanceTest.History.Period("VTI", "1mo", "1d")
+			if tt.strict {
+				call = call.Strict()
+			}
+			_, err := call.Do()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PeriodCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+				t.Errorf("PeriodCall.Do() error = %v, want %v", err, tt.wantIs)
+			}
+		})
+	}
+}
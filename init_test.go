@@ -45,3 +45,32 @@ func clientTest(body string, statuscode int) *http.Client {
 
 	return client
 }
+
+type RouteTransport struct {
+	routes map[string]string
+}
+
+// RoundTrip reply the body of request url
+func (t *RouteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var res http.Response
+	body, ok := t.routes[req.URL.String()]
+	res.StatusCode = http.StatusOK
+	if !ok {
+		res.StatusCode = http.StatusNotFound
+	}
+	res.Body = ioutil.NopCloser(strings.NewReader(body))
+	res.Header = http.Header{}
+	res.Request = req
+
+	return &res, nil
+}
+
+func clientRoutes(routes map[string]string) *http.Client {
+	transport := &RouteTransport{routes: routes}
+
+	client := &http.Client{
+		Transport: transport,
+	}
+
+	return client
+}
@@ -0,0 +1,161 @@
+package yahoofinance
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Interval granularity of history
+// Any string converts to Interval, use ParseInterval to validate user input
+type Interval string
+
+// Valid intervals
+const (
+	Interval1m  Interval = "1m"
+	Interval2m  Interval = "2m"
+	Interval5m  Interval = "5m"
+	Interval15m Interval = "15m"
+	Interval30m Interval = "30m"
+	Interval60m Interval = "60m"
+	Interval90m Interval = "90m"
+	Interval1h  Interval = "1h"
+	Interval1d  Interval = "1d"
+	Interval5d  Interval = "5d"
+	Interval1wk Interval = "1wk"
+	Interval1mo Interval = "1mo"
+	Interval3mo Interval = "3mo"
+)
+
+// intervalDurations months are approximated by 30 days
+var intervalDurations = map[Interval]time.Duration{
+	Interval1m:  time.Minute,
+	Interval2m:  2 * time.Minute,
+	Interval5m:  5 * time.Minute,
+	Interval15m: 15 * time.Minute,
+	Interval30m: 30 * time.Minute,
+	Interval60m: time.Hour,
+	Interval90m: 90 * time.Minute,
+	Interval1h:  time.Hour,
+	Interval1d:  day,
+	Interval5d:  5 * day,
+	Interval1wk: 7 * day,
+	Interval1mo: 30 * day,
+	Interval3mo: 90 * day,
+}
+
+// ParseInterval parses s case-insensitively, e.g. "1D" or "1wk"
+func ParseInterval(s string) (Interval, error) {
+	i := Interval(strings.ToLower(strings.TrimSpace(s)))
+	if !i.Valid() {
+		return "", errors.Wrapf(ErrInvalidRange, "interval %q is not supported", s)
+	}
+
+	return i, nil
+}
+
+// Valid reports whether i is one of the valid intervals
+func (i Interval) Valid() bool {
+	_, ok := intervalDurations[i]
+	return ok
+}
+
+// Intraday reports whether i is shorter than a day
+func (i Interval) Intraday() bool {
+	return i.Valid() && i.Duration() < day
+}
+
+// Duration length of i, 0 if invalid
+// 1mo and 3mo are approximated by 30 and 90 days
+func (i Interval) Duration() time.Duration {
+	return intervalDurations[i]
+}
+
+func (i Interval) String() string {
+	return string(i)
+}
+
+// Range period of history ending now
+// Any string converts to Range, use ParseRange to validate user input
+type Range string
+
+// Valid ranges
+const (
+	Range1d  Range = "1d"
+	Range5d  Range = "5d"
+	Range1mo Range = "1mo"
+	Range3mo Range = "3mo"
+	Range6mo Range = "6mo"
+	Range1y  Range = "1y"
+	Range2y  Range = "2y"
+	Range5y  Range = "5y"
+	Range10y Range = "10y"
+	RangeYTD Range = "ytd"
+	RangeMax Range = "max"
+)
+
+// rangeDurations months are approximated by 30 days and years by 365 days
+var rangeDurations = map[Range]time.Duration{
+	Range1d:  day,
+	Range5d:  5 * day,
+	Range1mo: 30 * day,
+	Range3mo: 90 * day,
+	Range6mo: 180 * day,
+	Range1y:  365 * day,
+	Range2y:  2 * 365 * day,
+	Range5y:  5 * 365 * day,
+	Range10y: 10 * 365 * day,
+	RangeYTD: 0,
+	RangeMax: 0,
+}
+
+// ParseRange parses s case-insensitively, e.g. "1MO" or "ytd"
+func ParseRange(s string) (Range, error) {
+	r := Range(strings.ToLower(strings.TrimSpace(s)))
+	if !r.Valid() {
+		return "", errors.Wrapf(ErrInvalidRange, "range %q is not supported", s)
+	}
+
+	return r, nil
+}
+
+// Valid reports whether r is one of the valid ranges
+func (r Range) Valid() bool {
+	_, ok := rangeDurations[r]
+	return ok
+}
+
+// Duration length of r, ytd is counted from January 1 of this year in UTC,
+// max and invalid ranges are 0
+func (r Range) Duration() time.Duration {
+	if r == RangeYTD {
+		now := time.Now().UTC()
+		return now.Sub(time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC))
+	}
+	return rangeDurations[r]
+}
+
+func (r Range) String() string {
+	return string(r)
+}
+
+// ValidateRange reports whether Yahoo accepts interval i over range r,
+// intraday intervals are limited per request, e.g. 1m to 7 days
+func ValidateRange(r Range, i Interval) error {
+	if !r.Valid() {
+		return errors.Wrapf(ErrInvalidRange, "range %q is not supported", r)
+	}
+	if !i.Valid() {
+		return errors.Wrapf(ErrInvalidRange, "interval %q is not supported", i)
+	}
+	limit, ok := intradayLimits[i]
+	if !ok {
+		return nil
+	}
+	if r == RangeMax || r.Duration() > limit.window {
+		return errors.Wrapf(ErrInvalidRange, "%s data cannot extend %d days, range %s", i, limit.window/day, r)
+	}
+
+	return nil
+}
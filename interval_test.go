@@ -0,0 +1,149 @@
+package yahoofinance
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Interval
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", "1d", Interval1d, false},
+		{"Upper", " 1WK", Interval1wk, false},
+		{"Typo", "1day", "", true},
+		{"Empty", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInterval(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseInterval() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseInterval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterval_Duration(t *testing.T) {
+	tests := []struct {
+		name         string
+		i            Interval
+		want         time.Duration
+		wantIntraday bool
+	}{
+		// TODO: Add test cases.
+		{"1m", Interval1m, time.Minute, true},
+		{"90m", Interval90m, 90 * time.Minute, true},
+		{"1d", Interval1d, 24 * time.Hour, false},
+		{"1wk", Interval1wk, 7 * 24 * time.Hour, false},
+		{"Invalid", "1day", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.i.Duration(); got != tt.want {
+				t.Errorf("Interval.Duration() = %v, want %v", got, tt.want)
+			}
+			if got := tt.i.Intraday(); got != tt.wantIntraday {
+				t.Errorf("Interval.Intraday() = %v, want %v", got, tt.wantIntraday)
+			}
+		})
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Range
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", "1mo", Range1mo, false},
+		{"Upper", "YTD", RangeYTD, false},
+		{"Typo", "1month", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRange(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       Range
+		i       Interval
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Daily", RangeMax, Interval1d, false},
+		{"1m", Range5d, Interval1m, false},
+		{"1mTooLong", Range1mo, Interval1m, true},
+		{"5m", Range1mo, Interval5m, false},
+		{"5mTooLong", Range3mo, Interval5m, true},
+		{"60m", Range2y, Interval60m, false},
+		{"60mMax", RangeMax, Interval60m, true},
+		{"Range", "1month", Interval1d, true},
+		{"Interval", Range1mo, "1day", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRange(tt.r, tt.i)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRange) {
+				t.Errorf("ValidateRange() error = %v, want %v", err, ErrInvalidRange)
+			}
+		})
+	}
+}
+
+func TestPeriodCall_Do_ValidateRange(t *testing.T) {
+	transport := &SeqTransport{replies: []reply{{statusCode: http.StatusOK}}}
+	yfinanceTest, _ := New(&http.Client{Transport: transport})
+
+	tests := []struct {
+		name      string
+		c         *PeriodCall
+		wantCount int
+		wantErr   bool
+	}{
+		// TODO: Add test cases.
+		{"Valid", yfinanceTest.History.Period("VTI", Range5d, Interval1m), 1, false},
+		{"Invalid", yfinanceTest.History.Period("VTI", Range1mo, Interval1m), 0, true},
+		{"Typo", yfinanceTest.History.Period("VTI", "1mo", "1day"), 0, true},
+		{"Unchecked", yfinanceTest.History.Period("VTI", "1mo", "1day").Unchecked(), 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport.count = 0
+			_, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PeriodCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if transport.count != tt.wantCount {
+				t.Errorf("PeriodCall.Do() sent %d requests, want %d", transport.count, tt.wantCount)
+			}
+		})
+	}
+}
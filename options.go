@@ -0,0 +1,134 @@
+package yahoofinance
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// NewOptionsService get options
+func NewOptionsService(s *Service) *OptionsService {
+	rs := &OptionsService{s: s}
+	return rs
+}
+
+// OptionsService get options
+type OptionsService struct {
+	s *Service
+}
+
+// Chain get option chain, Default is the nearest expiration
+// https://query1.finance.yahoo.com/v7/finance/options/AAPL?date=1608249600
+func (r *OptionsService) Chain(symbol string) *ChainCall {
+	c := &ChainCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+
+	return c
+}
+
+// ChainCall call function
+type ChainCall struct {
+	DefaultCall
+
+	symbol string
+	all    bool
+}
+
+// Date get the expiration at date, which is one of ExpirationDates
+func (c *ChainCall) Date(date time.Time) *ChainCall {
+	c.urlParams.Set("date", strconv.FormatInt(date.Unix(), 10))
+	return c
+}
+
+// All get every expiration, one request per expiration
+func (c *ChainCall) All() *ChainCall {
+	c.all = true
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *ChainCall) Context(ctx context.Context) *ChainCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *ChainCall) SetHeader(key, value string) *ChainCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *ChainCall) Retry(policy *RetryPolicy) *ChainCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send request with ctx
+func (c *ChainCall) DoContext(ctx context.Context) (*OptionsInfomation, error) {
+	return c.Context(ctx).Do()
+}
+
+func (c *ChainCall) doRequest() (*http.Response, error) {
+	return c.execute("/v7/finance/options", c.symbol)
+}
+
+// Do send request
+func (c *ChainCall) Do() (*OptionsInfomation, error) {
+	ret, err := c.do()
+	if err != nil {
+		return nil, err
+	}
+	if !c.all || len(ret.OptionChain.Result) == 0 {
+		return ret, nil
+	}
+
+	r := &ret.OptionChain.Result[0]
+	fetched := map[int64]bool{}
+	for _, o := range r.Options {
+		fetched[o.ExpirationDate] = true
+	}
+	for _, date := range r.ExpirationDates {
+		if fetched[date] {
+			continue
+		}
+
+		next := &ChainCall{
+			DefaultCall: c.DefaultCall,
+			symbol:      c.symbol,
+		}
+		next.urlParams = cloneValues(c.urlParams)
+		info, err := next.Date(time.Unix(date, 0)).do()
+		if err != nil {
+			return nil, errors.Wrapf(err, "date %d", date)
+		}
+		if len(info.OptionChain.Result) > 0 {
+			r.Options = append(r.Options, info.OptionChain.Result[0].Options...)
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *ChainCall) do() (*OptionsInfomation, error) {
+	res, err := c.doRequest()
+	ret := &OptionsInfomation{}
+	if ret.ServerResponse, err = readResponse(ret, res, err); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
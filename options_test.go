@@ -0,0 +1,168 @@
+package yahoofinance
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestChainCall_doRequest(t *testing.T) {
+	client := clientTest("", http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *ChainCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewOptionsService(yfinanceTest).Chain("AAPL"),
+			"https://query1.finance.yahoo.com/v7/finance/options/AAPL?", false},
+		{"Date", NewOptionsService(yfinanceTest).Chain("AAPL").Date(time.Unix(1608249600, 0)),
+			"https://query1.finance.yahoo.com/v7/finance/options/AAPL?date=1608249600", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.doRequest()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ChainCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.Request.URL.String()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChainCall.doRequest() = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChainCall_Do(t *testing.T) {
+	first := `{
+		"optionChain": {
+		  "result": [
+			{
+			  "underlyingSymbol": "AAPL",
+			  "expirationDates": [1608249600, 1608768000],
+			  "strikes": [120, 125],
+			  "hasMiniOptions": false,
+			  "quote": {"symbol": "AAPL", "regularMarketPrice": 123.75},
+			  "options": [
+				{
+				  "expirationDate": 1608249600,
+				  "hasMiniOptions": false,
+				  "calls": [
+					{
+					  "contractSymbol": "AAPL201218C00120000",
+					  "strike": 120,
+					  "currency": "USD",
+					  "lastPrice": 4.4,
+					  "volume": 23469,
+					  "openInterest": 45121,
+					  "bid": 4.35,
+					  "ask": 4.45,
+					  "contractSize": "REGULAR",
+					  "expiration": 1608249600,
+					  "lastTradeDate": 1607720398,
+					  "impliedVolatility": 0.3379,
+					  "inTheMoney": true
+					}
+				  ],
+				  "puts": []
+				}
+			  ]
+			}
+		  ],
+		  "error": null
+		}
+	  }`
+	second := `{
+		"optionChain": {
+		  "result": [
+			{
+			  "underlyingSymbol": "AAPL",
+			  "expirationDates": [1608249600, 1608768000],
+			  "strikes": [125],
+			  "options": [
+				{
+				  "expirationDate": 1608768000,
+				  "calls": [],
+				  "puts": [
+					{
+					  "contractSymbol": "AAPL201224P00125000",
+					  "strike": 125,
+					  "lastPrice": 3.05,
+					  "expiration": 1608768000,
+					  "inTheMoney": true
+					}
+				  ]
+				}
+			  ]
+			}
+		  ],
+		  "error": null
+		}
+	  }`
+	client := clientRoutes(map[string]string{
+		"https://query1.finance.yahoo.com/v7/finance/options/AAPL?":                first,
+		"https://query1.finance.yahoo.com/v7/finance/options/AAPL?date=1608768000": second,
+	})
+	yfinanceTest, _ := New(client)
+
+	call := &OptionContract{
+		ContractSymbol:    "AAPL201218C00120000",
+		Strike:            120,
+		Currency:          "USD",
+		LastPrice:         4.4,
+		Volume:            23469,
+		OpenInterest:      45121,
+		Bid:               4.35,
+		Ask:               4.45,
+		ContractSize:      "REGULAR",
+		Expiration:        1608249600,
+		LastTradeDate:     1607720398,
+		ImpliedVolatility: 0.3379,
+		InTheMoney:        true,
+	}
+	put := &OptionContract{
+		ContractSymbol: "AAPL201224P00125000",
+		Strike:         125,
+		LastPrice:      3.05,
+		Expiration:     1608768000,
+		InTheMoney:     true,
+	}
+
+	tests := []struct {
+		name    string
+		c       *ChainCall
+		want    []ExpirationOptions
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Nearest", NewOptionsService(yfinanceTest).Chain("AAPL"), []ExpirationOptions{
+			{ExpirationDate: 1608249600, Calls: []OptionContract{*call}, Puts: []OptionContract{}},
+		}, false},
+		{"All", NewOptionsService(yfinanceTest).Chain("AAPL").All(), []ExpirationOptions{
+			{ExpirationDate: 1608249600, Calls: []OptionContract{*call}, Puts: []OptionContract{}},
+			{ExpirationDate: 1608768000, Calls: []OptionContract{}, Puts: []OptionContract{*put}},
+		}, false},
+		{"NotFound", NewOptionsService(yfinanceTest).Chain("AAPL").Date(time.Unix(1609372800, 0)), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ChainCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			got := rsp.OptionChain.Result[0].Options
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChainCall.Do() = \n%+v, \nwant \n%+v", got, tt.want)
+			}
+		})
+	}
+}
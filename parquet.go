@@ -0,0 +1,708 @@
+package yahoofinance
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// parquetMagic starts and ends a Parquet file
+const parquetMagic = "PAR1"
+
+// parquetCreatedBy written to the footer
+const parquetCreatedBy = "github.com/z-Wind/yahoofinance"
+
+// Parquet physical types, repetitions, encodings and converted types
+const (
+	ptInt64     = 2
+	ptDouble    = 5
+	ptByteArray = 6
+
+	prRequired = 0
+	prOptional = 1
+
+	peRLE   = 3
+	pePlain = 0
+
+	pcUTF8            = 0
+	pcTimestampMillis = 9
+)
+
+// logical types of parquetColumn
+const (
+	plNone = iota
+	plString
+	plTimestamp
+)
+
+// parquetColumn column of the schema
+type parquetColumn struct {
+	name     string
+	typ      int32
+	optional bool
+	logical  int
+}
+
+// columns of the schema, in order
+const (
+	pqSymbol = iota
+	pqTimestamp
+	pqTimezone
+	pqOpen
+	pqHigh
+	pqLow
+	pqClose
+	pqVolume
+	pqAdjclose
+	pqCurrency
+	pqInterval
+	pqColumns
+)
+
+// parquetSchema stable schema of the files, one row per bar
+// timestamp is milliseconds since the epoch in UTC and timezone is the IANA
+// name of the exchange's location, or a fixed offset like UTC-05:00
+var parquetSchema = [pqColumns]parquetColumn{
+	pqSymbol:    {"symbol", ptByteArray, false, plString},
+	pqTimestamp: {"timestamp", ptInt64, false, plTimestamp},
+	pqTimezone:  {"timezone", ptByteArray, false, plString},
+	pqOpen:      {"open", ptDouble, true, plNone},
+	pqHigh:      {"high", ptDouble, true, plNone},
+	pqLow:       {"low", ptDouble, true, plNone},
+	pqClose:     {"close", ptDouble, true, plNone},
+	pqVolume:    {"volume", ptInt64, true, plNone},
+	pqAdjclose:  {"adjclose", ptDouble, true, plNone},
+	pqCurrency:  {"currency", ptByteArray, false, plString},
+	pqInterval:  {"interval", ptByteArray, false, plString},
+}
+
+// parquetZone name of the location of m, see parquetSchema
+func parquetZone(m *Meta) string {
+	if m.ExchangeTimezoneName != "" {
+		if _, err := time.LoadLocation(m.ExchangeTimezoneName); err == nil {
+			return m.ExchangeTimezoneName
+		}
+	}
+	if m.Gmtoffset == 0 {
+		return "UTC"
+	}
+	return "UTC" + time.Unix(0, 0).In(time.FixedZone("", int(m.Gmtoffset))).Format("-07:00")
+}
+
+// parquetMeta meta of zone at ts
+func parquetMeta(zone string, ts int64) (Meta, error) {
+	if zone != "UTC" && strings.HasPrefix(zone, "UTC") {
+		t, err := time.Parse("-07:00", zone[3:])
+		if err != nil {
+			return Meta{}, errors.Wrapf(err, "timezone %s", zone)
+		}
+		_, offset := t.Zone()
+		return Meta{Timezone: zone, Gmtoffset: int64(offset)}, nil
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return Meta{}, errors.Wrapf(err, "time.LoadLocation")
+	}
+	name, offset := time.Unix(ts, 0).In(loc).Zone()
+	return Meta{ExchangeTimezoneName: zone, Timezone: name, Gmtoffset: int64(offset)}, nil
+}
+
+// parquetValues PLAIN encoded values of a column and the definition levels of optional ones
+type parquetValues struct {
+	data []byte
+	defs []bool
+	n    int
+}
+
+func (v *parquetValues) bytes(s string) {
+	v.data = append(v.data, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(v.data[len(v.data)-4:], uint32(len(s)))
+	v.data = append(v.data, s...)
+	v.n++
+}
+
+func (v *parquetValues) int64(x int64) {
+	v.data = append(v.data, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint64(v.data[len(v.data)-8:], uint64(x))
+	v.n++
+}
+
+func (v *parquetValues) double(x NullFloat64) {
+	v.defs = append(v.defs, x.Valid)
+	if x.Valid {
+		v.data = append(v.data, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint64(v.data[len(v.data)-8:], math.Float64bits(x.Float64))
+	}
+	v.n++
+}
+
+func (v *parquetValues) nullInt64(x NullFloat64) {
+	v.defs = append(v.defs, x.Valid)
+	if x.Valid {
+		v.data = append(v.data, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint64(v.data[len(v.data)-8:], uint64(int64(x.Float64)))
+	}
+	v.n++
+}
+
+// page data page of the values
+func (v *parquetValues) page(optional bool) []byte {
+	var payload []byte
+	if optional {
+		levels := encodeLevels(v.defs)
+		payload = make([]byte, 4, 4+len(levels)+len(v.data))
+		binary.LittleEndian.PutUint32(payload, uint32(len(levels)))
+		payload = append(payload, levels...)
+	}
+	payload = append(payload, v.data...)
+
+	w := &compactWriter{}
+	w.begin()
+	w.i32(1, 0) // DATA_PAGE
+	w.i32(2, int32(len(payload)))
+	w.i32(3, int32(len(payload)))
+	w.structField(5)
+	w.i32(1, int32(v.n))
+	w.i32(2, pePlain)
+	w.i32(3, peRLE)
+	w.i32(4, peRLE)
+	w.end()
+	w.end()
+
+	return append(w.b, payload...)
+}
+
+// encodeLevels RLE encodes definition levels of bit width 1
+func encodeLevels(defs []bool) []byte {
+	w := &compactWriter{}
+	for i := 0; i < len(defs); {
+		j := i + 1
+		for j < len(defs) && defs[j] == defs[i] {
+			j++
+		}
+		w.varint(uint64(j-i) << 1)
+		if defs[i] {
+			w.b = append(w.b, 1)
+		} else {
+			w.b = append(w.b, 0)
+		}
+		i = j
+	}
+	return w.b
+}
+
+// decodeLevels decodes n RLE/bit-packed hybrid definition levels of bit width 1
+func decodeLevels(b []byte, n int) ([]bool, error) {
+	r := &compactReader{b: b}
+	defs := make([]bool, 0, n)
+	for len(defs) < n {
+		h, err := r.varint()
+		if err != nil {
+			return nil, errors.Wrapf(err, "definition levels")
+		}
+		if h&1 == 0 {
+			v, err := r.byte()
+			if err != nil {
+				return nil, errors.Wrapf(err, "definition levels")
+			}
+			for i := uint64(0); i < h>>1 && len(defs) < n; i++ {
+				defs = append(defs, v == 1)
+			}
+			continue
+		}
+
+		packed, err := r.bytes(int(h >> 1))
+		if err != nil {
+			return nil, errors.Wrapf(err, "definition levels")
+		}
+		for _, v := range packed {
+			for bit := uint(0); bit < 8 && len(defs) < n; bit++ {
+				defs = append(defs, v>>bit&1 == 1)
+			}
+		}
+	}
+	return defs, nil
+}
+
+// parquetChunk column chunk written to the file
+type parquetChunk struct {
+	offset, size int64
+	numValues    int
+}
+
+// parquetRowGroup row group written to the file
+type parquetRowGroup struct {
+	numRows int
+	size    int64
+	chunks  [pqColumns]parquetChunk
+}
+
+// NewParquetWriter writes Results to w as Parquet, see ParquetWriter
+func NewParquetWriter(w io.Writer) *ParquetWriter {
+	return &ParquetWriter{w: w}
+}
+
+// ParquetWriter writes bars of Results in a stable schema:
+// symbol, timestamp (UTC milliseconds), timezone, open, high, low, close,
+// volume, adjclose, currency and interval. Missing values are null.
+// Each Write appends a row group, so many symbols can be written in batches,
+// and Close writes the footer. Pages are uncompressed and PLAIN encoded.
+type ParquetWriter struct {
+	w         io.Writer
+	offset    int64
+	rowGroups []parquetRowGroup
+	closed    bool
+	err       error
+}
+
+func (w *ParquetWriter) write(b []byte) error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.offset == 0 {
+		n, err := io.WriteString(w.w, parquetMagic)
+		w.offset += int64(n)
+		if err != nil {
+			w.err = errors.Wrapf(err, "Write")
+			return w.err
+		}
+	}
+	n, err := w.w.Write(b)
+	w.offset += int64(n)
+	if err != nil {
+		w.err = errors.Wrapf(err, "Write")
+	}
+	return w.err
+}
+
+// Write appends the bars of results as a row group
+func (w *ParquetWriter) Write(results ...*Result) error {
+	var cols [pqColumns]parquetValues
+	rows := 0
+	for _, r := range results {
+		q, adjclose, err := r.series()
+		if err != nil {
+			return errors.Wrapf(err, "series %s", r.Meta.Symbol)
+		}
+		if q == nil {
+			continue
+		}
+
+		zone := parquetZone(&r.Meta)
+		for i, ts := range r.Timestamp {
+			cols[pqSymbol].bytes(r.Meta.Symbol)
+			cols[pqTimestamp].int64(ts * 1000)
+			cols[pqTimezone].bytes(zone)
+			cols[pqOpen].double(q.Open[i])
+			cols[pqHigh].double(q.High[i])
+			cols[pqLow].double(q.Low[i])
+			cols[pqClose].double(q.Close[i])
+			cols[pqVolume].nullInt64(q.Volume[i])
+			if adjclose != nil {
+				cols[pqAdjclose].double(adjclose[i])
+			} else {
+				cols[pqAdjclose].double(NullFloat64{})
+			}
+			cols[pqCurrency].bytes(r.Meta.Currency)
+			cols[pqInterval].bytes(r.Meta.DataGranularity)
+		}
+		rows += len(r.Timestamp)
+	}
+	if rows == 0 {
+		return w.err
+	}
+
+	// the magic comes first
+	if err := w.write(nil); err != nil {
+		return err
+	}
+	g := parquetRowGroup{numRows: rows}
+	for i, col := range parquetSchema {
+		page := cols[i].page(col.optional)
+		g.chunks[i] = parquetChunk{offset: w.offset, size: int64(len(page)), numValues: rows}
+		if err := w.write(page); err != nil {
+			return err
+		}
+		g.size += int64(len(page))
+	}
+	w.rowGroups = append(w.rowGroups, g)
+
+	return nil
+}
+
+// errParquetClosed Write after Close
+var errParquetClosed = errors.New("parquet: writer closed")
+
+// Close writes the footer once, it does not close the underlying writer
+func (w *ParquetWriter) Close() error {
+	if w.closed {
+		if w.err == errParquetClosed {
+			return nil
+		}
+		return w.err
+	}
+	w.closed = true
+
+	footer := w.footer()
+	size := make([]byte, 4)
+	binary.LittleEndian.PutUint32(size, uint32(len(footer)))
+	footer = append(append(footer, size...), parquetMagic...)
+
+	if err := w.write(footer); err != nil {
+		return err
+	}
+	w.err = errParquetClosed
+	return nil
+}
+
+// footer FileMetaData
+func (w *ParquetWriter) footer() []byte {
+	numRows := 0
+	for _, g := range w.rowGroups {
+		numRows += g.numRows
+	}
+
+	e := &compactWriter{}
+	e.begin()
+	e.i32(1, 1)
+	e.list(2, ctStruct, pqColumns+1)
+	e.begin()
+	e.binary(4, "schema")
+	e.i32(5, pqColumns)
+	e.end()
+	for _, col := range parquetSchema {
+		e.begin()
+		e.i32(1, col.typ)
+		if col.optional {
+			e.i32(3, prOptional)
+		} else {
+			e.i32(3, prRequired)
+		}
+		e.binary(4, col.name)
+		switch col.logical {
+		case plString:
+			e.i32(6, pcUTF8)
+			e.structField(10)
+			e.structField(1)
+			e.end()
+			e.end()
+		case plTimestamp:
+			e.i32(6, pcTimestampMillis)
+			e.structField(10)
+			e.structField(8)
+			e.bool(1, true)
+			e.structField(2)
+			e.structField(1)
+			e.end()
+			e.end()
+			e.end()
+			e.end()
+		}
+		e.end()
+	}
+	e.i64(3, int64(numRows))
+	e.list(4, ctStruct, len(w.rowGroups))
+	for _, g := range w.rowGroups {
+		e.begin()
+		e.list(1, ctStruct, pqColumns)
+		for i, col := range parquetSchema {
+			c := g.chunks[i]
+			e.begin()
+			e.i64(2, c.offset)
+			e.structField(3)
+			e.i32(1, col.typ)
+			e.list(2, ctI32, 2)
+			e.elemI32(pePlain)
+			e.elemI32(peRLE)
+			e.list(3, ctBinary, 1)
+			e.elemBinary(col.name)
+			e.i32(4, 0) // UNCOMPRESSED
+			e.i64(5, int64(c.numValues))
+			e.i64(6, c.size)
+			e.i64(7, c.size)
+			e.i64(9, c.offset)
+			e.end()
+			e.end()
+		}
+		e.i64(2, g.size)
+		e.i64(3, int64(g.numRows))
+		e.end()
+	}
+	e.binary(6, parquetCreatedBy)
+	e.end()
+
+	return e.b
+}
+
+// parquetData decoded values of a column, valid is false for nulls
+type parquetData struct {
+	ints   []int64
+	floats []float64
+	strs   []string
+	valid  []bool
+}
+
+// ReadParquet reads a file written by ParquetWriter back into Results,
+// one per symbol and interval in order of appearance.
+// Adjclose is absent if all of its values are null.
+// Other writers' files with the same schema can be read if their pages
+// are uncompressed and PLAIN encoded.
+func ReadParquet(r io.ReaderAt, size int64) ([]*Result, error) {
+	if size < int64(2*len(parquetMagic)+4) {
+		return nil, errors.New("parquet: file too small")
+	}
+	tail := make([]byte, 8)
+	if _, err := r.ReadAt(tail, size-8); err != nil {
+		return nil, errors.Wrapf(err, "ReadAt")
+	}
+	if string(tail[4:]) != parquetMagic {
+		return nil, errors.New("parquet: invalid magic")
+	}
+	n := int64(binary.LittleEndian.Uint32(tail))
+	if n > size-8-int64(len(parquetMagic)) {
+		return nil, errors.Errorf("parquet: invalid footer length %d", n)
+	}
+	b := make([]byte, n)
+	if _, err := r.ReadAt(b, size-8-n); err != nil {
+		return nil, errors.Wrapf(err, "ReadAt")
+	}
+	meta, err := (&compactReader{b: b}).readStruct()
+	if err != nil {
+		return nil, errors.Wrapf(err, "FileMetaData")
+	}
+
+	// locate columns of the schema by name
+	index := map[string]int{}
+	schema := meta.list(2)
+	for i, e := range schema {
+		if i == 0 {
+			continue
+		}
+		if s, ok := e.(thriftStruct); ok {
+			index[s.str(4)] = i - 1
+		}
+	}
+	var columns [pqColumns]int
+	var optional [pqColumns]bool
+	for i, col := range parquetSchema {
+		j, ok := index[col.name]
+		if !ok {
+			return nil, errors.Errorf("parquet: column %s not found", col.name)
+		}
+		s, ok := schema[j+1].(thriftStruct)
+		if !ok {
+			return nil, errors.Errorf("parquet: invalid schema of column %s", col.name)
+		}
+		if int32(s.int(1)) != col.typ {
+			return nil, errors.Errorf("parquet: column %s of type %d", col.name, s.int(1))
+		}
+		columns[i] = j
+		optional[i] = s.int(3) == prOptional
+	}
+
+	var results []*Result
+	groups := map[string]*Result{}
+	for n, v := range meta.list(4) {
+		g, ok := v.(thriftStruct)
+		if !ok {
+			return nil, errors.Errorf("parquet: invalid row group %d", n)
+		}
+		chunks := g.list(1)
+		// a row takes the 4 byte length of its symbol at least
+		rows := g.int(3)
+		if rows < 0 || rows > size/4 {
+			return nil, errors.Errorf("parquet: row group %d: invalid number of rows %d", n, rows)
+		}
+		var data [pqColumns]*parquetData
+		for i, j := range columns {
+			if j >= len(chunks) {
+				return nil, errors.Errorf("parquet: row group %d: column %s not found", n, parquetSchema[i].name)
+			}
+			col, _ := chunks[j].(thriftStruct)
+			if data[i], err = readParquetChunk(r, size, col.strct(3), rows, parquetSchema[i].typ, optional[i]); err != nil {
+				return nil, errors.Wrapf(err, "row group %d: column %s", n, parquetSchema[i].name)
+			}
+		}
+
+		for i := 0; i < int(rows); i++ {
+			if err := appendParquetRow(&results, groups, &data, i); err != nil {
+				return nil, errors.Wrapf(err, "row group %d", n)
+			}
+		}
+	}
+
+	for _, r := range results {
+		valid := false
+		for _, v := range r.Indicators.Adjclose[0].Value {
+			valid = valid || v.Valid
+		}
+		if !valid {
+			r.Indicators.Adjclose = nil
+		}
+	}
+
+	return results, nil
+}
+
+// appendParquetRow appends row i of data to the Result of its symbol and interval
+func appendParquetRow(results *[]*Result, groups map[string]*Result, data *[pqColumns]*parquetData, i int) error {
+	float := func(c int) NullFloat64 {
+		return NullFloat64{Float64: data[c].floats[i], Valid: data[c].valid[i]}
+	}
+
+	ms := data[pqTimestamp].ints[i]
+	ts := ms / 1000
+	if ms%1000 < 0 {
+		ts--
+	}
+
+	symbol, interval := data[pqSymbol].strs[i], data[pqInterval].strs[i]
+	key := symbol + "\x00" + interval
+	r, ok := groups[key]
+	if !ok {
+		meta, err := parquetMeta(data[pqTimezone].strs[i], ts)
+		if err != nil {
+			return errors.Wrapf(err, "parquetMeta")
+		}
+		meta.Symbol = symbol
+		meta.Currency = data[pqCurrency].strs[i]
+		meta.DataGranularity = interval
+
+		r = &Result{Meta: meta}
+		r.Indicators.Quote = []Quote{{}}
+		r.Indicators.Adjclose = []Adjclose{{}}
+		groups[key] = r
+		*results = append(*results, r)
+	}
+
+	q := &r.Indicators.Quote[0]
+	r.Timestamp = append(r.Timestamp, ts)
+	q.Open = append(q.Open, float(pqOpen))
+	q.High = append(q.High, float(pqHigh))
+	q.Low = append(q.Low, float(pqLow))
+	q.Close = append(q.Close, float(pqClose))
+	q.Volume = append(q.Volume, NullFloat64{Float64: float64(data[pqVolume].ints[i]), Valid: data[pqVolume].valid[i]})
+	r.Indicators.Adjclose[0].Value = append(r.Indicators.Adjclose[0].Value, float(pqAdjclose))
+
+	return nil
+}
+
+// readParquetChunk reads the values of the column chunk of meta in a file of size,
+// one per row of its row group
+func readParquetChunk(r io.ReaderAt, size int64, meta thriftStruct, rows int64, typ int32, optional bool) (*parquetData, error) {
+	if meta == nil {
+		return nil, errors.New("parquet: no column metadata")
+	}
+	if codec := meta.int(4); codec != 0 {
+		return nil, errors.Errorf("parquet: unsupported codec %d", codec)
+	}
+	if _, ok := meta[11]; ok {
+		return nil, errors.New("parquet: unsupported dictionary page")
+	}
+
+	offset, length, values := meta.int(9), meta.int(7), meta.int(5)
+	if offset < int64(len(parquetMagic)) || length < 0 || length > size-offset {
+		return nil, errors.Errorf("parquet: invalid chunk at %d of %d bytes", offset, length)
+	}
+	if values != rows {
+		return nil, errors.Errorf("parquet: %d values, want %d", values, rows)
+	}
+
+	b := make([]byte, length)
+	if _, err := r.ReadAt(b, offset); err != nil {
+		return nil, errors.Wrapf(err, "ReadAt")
+	}
+
+	data := &parquetData{}
+	cr := &compactReader{b: b}
+	for int64(len(data.valid)) < values {
+		header, err := cr.readStruct()
+		if err != nil {
+			return nil, errors.Wrapf(err, "PageHeader")
+		}
+		page, err := cr.bytes(int(header.int(3)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "page")
+		}
+		if t := header.int(1); t != 0 {
+			return nil, errors.Errorf("parquet: unsupported page type %d", t)
+		}
+		dph := header.strct(5)
+		if e := dph.int(2); e != pePlain {
+			return nil, errors.Errorf("parquet: unsupported encoding %d", e)
+		}
+		n := dph.int(1)
+		if n <= 0 || n > values-int64(len(data.valid)) {
+			return nil, errors.Errorf("parquet: invalid number of page values %d", n)
+		}
+		if err := data.decode(page, int(n), typ, optional); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// decode appends the n values of a data page
+func (d *parquetData) decode(page []byte, n int, typ int32, optional bool) error {
+	defs := make([]bool, n)
+	for i := range defs {
+		defs[i] = true
+	}
+	if optional {
+		if len(page) < 4 {
+			return errors.New("parquet: short page")
+		}
+		size := int(binary.LittleEndian.Uint32(page))
+		if size > len(page)-4 {
+			return errors.New("parquet: short page")
+		}
+		var err error
+		if defs, err = decodeLevels(page[4:4+size], n); err != nil {
+			return err
+		}
+		page = page[4+size:]
+	}
+
+	pos := 0
+	for _, valid := range defs {
+		d.valid = append(d.valid, valid)
+		switch typ {
+		case ptInt64, ptDouble:
+			var v uint64
+			if valid {
+				if pos+8 > len(page) {
+					return errors.New("parquet: short page")
+				}
+				v = binary.LittleEndian.Uint64(page[pos:])
+				pos += 8
+			}
+			d.ints = append(d.ints, int64(v))
+			d.floats = append(d.floats, math.Float64frombits(v))
+		case ptByteArray:
+			var s string
+			if valid {
+				if pos+4 > len(page) {
+					return errors.New("parquet: short page")
+				}
+				size := int(binary.LittleEndian.Uint32(page[pos:]))
+				pos += 4
+				if size > len(page)-pos {
+					return errors.New("parquet: short page")
+				}
+				s = string(page[pos : pos+size])
+				pos += size
+			}
+			d.strs = append(d.strs, s)
+		default:
+			return errors.Errorf("parquet: unsupported type %d", typ)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package yahoofinance
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestParquet_RoundTrip(t *testing.T) {
+	nan := math.NaN()
+	vti := Meta{Symbol: "VTI", Currency: "USD", DataGranularity: "1d", ExchangeTimezoneName: "America/New_York", Timezone: "EST", Gmtoffset: -18000}
+	gspc := vti
+	gspc.Symbol = "^GSPC"
+	toyota := Meta{Symbol: "7203.T", Currency: "JPY", DataGranularity: "1h", Timezone: "JST", Gmtoffset: 32400}
+	noAdj := testResult(gspc, []int64{1607092200, 1607351400}, 0, 3699, 3691)
+	noAdj.Indicators.Adjclose = nil
+
+	tests := []struct {
+		name    string
+		batches [][]*Result
+		want    []*Result
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test",
+			[][]*Result{{testResult(vti, []int64{992611800, 992871000, 1607092200}, 0, 55.665, nan, 191.51)}},
+			[]*Result{testResult(Meta{Symbol: "VTI", Currency: "USD", DataGranularity: "1d", ExchangeTimezoneName: "America/New_York", Timezone: "EDT", Gmtoffset: -14400}, []int64{992611800, 992871000, 1607092200}, 0, 55.665, nan, 191.51)},
+			false},
+		{"Batches",
+			[][]*Result{
+				{testResult(vti, []int64{1607092200}, 0, 191.51), noAdj},
+				{},
+				{testResult(toyota, []int64{-3600, 1607040000}, 0, 7000, 7010)},
+				{testResult(vti, []int64{1607351400}, 0, 191.8)},
+			},
+			[]*Result{
+				testResult(vti, []int64{1607092200, 1607351400}, 0, 191.51, 191.8),
+				noAdj,
+				testResult(Meta{Symbol: "7203.T", Currency: "JPY", DataGranularity: "1h", Timezone: "UTC+09:00", Gmtoffset: 32400}, []int64{-3600, 1607040000}, 0, 7000, 7010),
+			},
+			false},
+		{"Mismatch", [][]*Result{{{Timestamp: []int64{1}, Indicators: Indicators{Quote: []Quote{{}}}}}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			w := NewParquetWriter(b)
+			for _, results := range tt.batches {
+				if err := w.Write(results...); (err != nil) != tt.wantErr {
+					t.Fatalf("ParquetWriter.Write() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			}
+			if tt.wantErr {
+				return
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("ParquetWriter.Close() error = %v", err)
+			}
+
+			got, err := ReadParquet(bytes.NewReader(b.Bytes()), int64(b.Len()))
+			if err != nil {
+				t.Fatalf("ReadParquet() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				for i := range got {
+					t.Logf("got[%d] = %+v", i, got[i])
+				}
+				t.Errorf("ReadParquet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// testParquetBatches batches of testdata/history.parquet
+func testParquetBatches() [][]*Result {
+	nan := math.NaN()
+	vti := Meta{Symbol: "VTI", Currency: "USD", DataGranularity: "1d", ExchangeTimezoneName: "America/New_York"}
+	gspc := vti
+	gspc.Symbol = "^GSPC"
+	noAdj := testResult(gspc, []int64{1607092200, 1607351400}, 0, 3699, 3691)
+	noAdj.Indicators.Adjclose = nil
+
+	// long enough for runs of definition levels over 15
+	ts := make([]int64, 40)
+	close := make([]float64, 40)
+	for i := range ts {
+		ts[i], close[i] = int64(i)*86400, float64(i)
+		if i%7 == 3 {
+			close[i] = nan
+		}
+	}
+
+	return [][]*Result{
+		{testResult(vti, []int64{992611800, 992871000, 1607092200}, 0, 55.665, nan, 191.51), noAdj},
+		{testResult(Meta{Symbol: "7203.T", Currency: "JPY", DataGranularity: "1h", Gmtoffset: 32400}, ts, 0, close...)},
+	}
+}
+
+// TestParquetWriter_Golden testdata/history.parquet is checked by the reader of Apache Arrow
+func TestParquetWriter_Golden(t *testing.T) {
+	want, err := ioutil.ReadFile("testdata/history.parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &bytes.Buffer{}
+	w := NewParquetWriter(b)
+	for _, results := range testParquetBatches() {
+		if err := w.Write(results...); err != nil {
+			t.Fatalf("ParquetWriter.Write() error = %v", err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if err := w.Close(); err != nil {
+			t.Fatalf("ParquetWriter.Close() error = %v", err)
+		}
+	}
+	if err := w.Write(testParquetBatches()[0]...); err == nil {
+		t.Errorf("ParquetWriter.Write() after Close error = nil, wantErr")
+	}
+	if !bytes.Equal(b.Bytes(), want) {
+		t.Errorf("ParquetWriter output differs from testdata/history.parquet")
+	}
+}
+
+// TestReadParquet_Reference testdata/arrow.parquet is written by Apache Arrow
+// in two row groups of small pages, without dictionary or compression
+func TestReadParquet_Reference(t *testing.T) {
+	f, err := os.Open("testdata/arrow.parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadParquet(f, info.Size())
+	if err != nil {
+		t.Fatalf("ReadParquet() error = %v", err)
+	}
+	nan := math.NaN()
+	want := []*Result{{
+		Meta:      Meta{Currency: "USD", Symbol: "VTI", DataGranularity: "1d", ExchangeTimezoneName: "America/New_York", Timezone: "EDT", Gmtoffset: -14400},
+		Timestamp: []int64{992611800, 992871000, 1607092200},
+		Indicators: Indicators{
+			Quote: []Quote{{
+				Volume: nullFloats(1067400, nan, 4401400),
+				Close:  nullFloats(55.665, nan, 191.51),
+				Open:   nullFloats(55.425, nan, 190),
+				High:   nullFloats(56.005, nan, 191.51),
+				Low:    nullFloats(55.175, nan, 189.99),
+			}},
+			Adjclose: []Adjclose{{nullFloats(38.816, nan, 191.51)}},
+		},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadParquet() = %+v, want %+v", got[0], want[0])
+	}
+}
+
+func TestReadParquet_Invalid(t *testing.T) {
+	b := &bytes.Buffer{}
+	w := NewParquetWriter(b)
+	if err := w.Write(testResult(Meta{Symbol: "VTI"}, []int64{1, 2}, 0, 1, math.NaN())); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	valid := b.Bytes()
+	footer := func(meta []byte) []byte {
+		f := append(append([]byte(parquetMagic), meta...), 0, 0, 0, 0)
+		binary.LittleEndian.PutUint32(f[len(f)-4:], uint32(len(meta)))
+		return append(f, parquetMagic...)
+	}
+
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		// TODO: Add test cases.
+		{"Empty", nil},
+		{"Magic", append(append([]byte{}, valid[:len(valid)-1]...), 'X')},
+		{"Footer", append(append([]byte{}, valid[:len(valid)-8]...), 0xff, 0xff, 0, 0, 'P', 'A', 'R', '1')},
+		{"Truncated", append(append([]byte{}, valid[:4]...), valid[len(valid)/2:]...)},
+		// list of lists of ...
+		{"Nested", footer(bytes.Repeat([]byte{0x19}, 100))},
+		// schema of i64 instead of structs
+		{"Schema", footer([]byte{0x29, 0x26, 2, 2, 0})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadParquet(bytes.NewReader(tt.b), int64(len(tt.b))); err == nil {
+				t.Errorf("ReadParquet() error = nil, wantErr")
+			}
+		})
+	}
+
+	// corrupted files must not panic
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		b := append([]byte{}, valid...)
+		for n := rnd.Intn(4); n >= 0; n-- {
+			b[rnd.Intn(len(b))] ^= byte(1 + rnd.Intn(255))
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					t.Fatalf("ReadParquet(%x) panic: %v", b, err)
+				}
+			}()
+			ReadParquet(bytes.NewReader(b), int64(len(b)))
+		}()
+	}
+}
@@ -0,0 +1,156 @@
+package yahoofinance
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// Handler sends req and returns its response
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps next to act before sending and after receiving
+type Middleware func(next Handler) Handler
+
+// BeforeSend middleware calling fn before req is sent, an error aborts the request
+func BeforeSend(fn func(req *http.Request) error) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := fn(req); err != nil {
+				return nil, err
+			}
+			return next(req)
+		}
+	}
+}
+
+// AfterReceive middleware calling fn after res is received, an error fails the request
+func AfterReceive(fn func(res *http.Response) error) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			res, err := next(req)
+			if err != nil {
+				return res, err
+			}
+			if err := fn(res); err != nil {
+				res.Body.Close()
+				return nil, err
+			}
+			return res, nil
+		}
+	}
+}
+
+// Use appends middlewares to every request of Service, the first one is the outermost
+// They run for every attempt, after rate limiting and authorization
+func (s *Service) Use(middlewares ...Middleware) {
+	s.middlewares = append(s.middlewares, middlewares...)
+}
+
+// handler builds the pipeline
+// retry -> cache -> failover -> rate limit -> authorization -> middlewares -> SendRequest
+func (s *Service) handler(policy *RetryPolicy) Handler {
+	h := Handler(func(req *http.Request) (*http.Response, error) {
+		return SendRequest(req.Context(), s.client, req)
+	})
+	for i := len(s.middlewares) - 1; i >= 0; i-- {
+		h = s.middlewares[i](h)
+	}
+	if s.auth != nil {
+		h = authorize(s.client, s.auth)(h)
+	}
+	if s.limiter != nil {
+		h = rateLimit(s.limiter)(h)
+	}
+	if s.hosts != nil {
+		h = failover(s.hosts)(h)
+	}
+	if s.cache != nil {
+		h = cache(s.cache)(h)
+	}
+
+	return retry(policy)(h)
+}
+
+// execute sends the GET request of the endpoint path elem through the pipeline
+func (c *DefaultCall) execute(elem ...string) (*http.Response, error) {
+	reqHeaders := make(http.Header)
+	for k, v := range c.header {
+		reqHeaders[k] = v
+	}
+	reqHeaders.Set("User-Agent", c.s.userAgent())
+	reqHeaders.Set("Accept", "application/json, text/javascript, */*; q=0.01")
+	// 無需設定 http.Transport 已自帶，並自動解碼，若加上會產生亂碼
+	// reqHeaders.Set("Accept-Encoding", "gzip, deflate, br")
+	reqHeaders.Set("Accept-Language", c.s.language)
+
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	params := cloneValues(c.urlParams)
+	if c.s.lang != "" && params.Get("lang") == "" {
+		params.Set("lang", c.s.lang)
+	}
+	if c.s.region != "" && params.Get("region") == "" {
+		params.Set("region", c.s.region)
+	}
+
+	var body io.Reader = nil
+	urls := ResolveRelative(c.s.host, elem...)
+	urls += "?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, "GET", urls, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "http.NewRequest")
+	}
+	req.Header = reqHeaders
+
+	policy := c.retry
+	if policy == nil {
+		policy = c.s.retry
+	}
+
+	return c.s.handler(policy)(req)
+}
+
+// readResponse checks the result of execute and decodes it into target
+func readResponse(target interface{}, res *http.Response, err error) (ServerResponse, error) {
+	if res != nil && res.StatusCode == http.StatusNotModified {
+		if res.Body != nil {
+			res.Body.Close()
+		}
+		return ServerResponse{}, &Error{
+			Code:   res.StatusCode,
+			Header: res.Header,
+		}
+	}
+	if err != nil {
+		return ServerResponse{}, errors.Wrapf(err, "doRequest")
+	}
+	defer res.Body.Close()
+	if err := CheckResponse(res); err != nil {
+		return ServerResponse{}, errors.Wrapf(err, "CheckResponse")
+	}
+
+	if err := DecodeResponse(target, res); err != nil {
+		return ServerResponse{}, errors.Wrapf(err, "DecodeResponse")
+	}
+
+	return ServerResponse{
+		Header:         res.Header,
+		HTTPStatusCode: res.StatusCode,
+	}, nil
+}
+
+// cloneValues copies v, so calls built from another one do not share parameters
+func cloneValues(v url.Values) url.Values {
+	c := make(url.Values, len(v))
+	for k, vs := range v {
+		c[k] = append([]string(nil), vs...)
+	}
+	return c
+}
@@ -0,0 +1,80 @@
+package yahoofinance
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestService_Use(t *testing.T) {
+	client := clientTest(`{"chart":{"result":[{"meta":{"symbol":"VTI"}}],"error":null}}`, http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	var got []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *http.Request) (*http.Response, error) {
+				got = append(got, name+" before")
+				res, err := next(req)
+				got = append(got, name+" after")
+				return res, err
+			}
+		}
+	}
+	yfinanceTest.Use(
+		trace("outer"),
+		BeforeSend(func(req *http.Request) error {
+			got = append(got, "BeforeSend "+req.URL.Path)
+			return nil
+		}),
+		AfterReceive(func(res *http.Response) error {
+			got = append(got, "AfterReceive "+http.StatusText(res.StatusCode))
+			return nil
+		}),
+		trace("inner"),
+	)
+
+	if _, err := yfinanceTest.History.Period("VTI", "1mo", "1d").Do(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"outer before",
+		"BeforeSend /v8/finance/chart/VTI",
+		"inner before",
+		"inner after",
+		"AfterReceive OK",
+		"outer after",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Service.Use() = \n%v, want \n%v", got, want)
+	}
+}
+
+func TestService_Use_Error(t *testing.T) {
+	errBefore := errors.New("before")
+	errAfter := errors.New("after")
+
+	tests := []struct {
+		name       string
+		middleware Middleware
+		want       error
+	}{
+		// TODO: Add test cases.
+		{"BeforeSend", BeforeSend(func(req *http.Request) error { return errBefore }), errBefore},
+		{"AfterReceive", AfterReceive(func(res *http.Response) error { return errAfter }), errAfter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := clientTest(`{}`, http.StatusOK)
+			yfinanceTest, _ := New(client)
+			yfinanceTest.Use(tt.middleware)
+
+			_, err := yfinanceTest.Quote.Symbols("VTI").Do()
+			if errors.Cause(err) != tt.want {
+				t.Errorf("SymbolsCall.Do() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,10 @@
 package yahoofinance
 
 import (
-	"io"
+	"context"
 	"net/http"
 	"net/url"
-
-	"github.com/pkg/errors"
+	"strings"
 )
 
 // NewQuoteService get history
@@ -61,58 +60,113 @@ type RegularMarketPriceCall struct {
 	symbol string
 }
 
-func (c *RegularMarketPriceCall) doRequest() (*http.Response, error) {
-	reqHeaders := make(http.Header)
-	for k, v := range c.header {
-		reqHeaders[k] = v
-	}
-	reqHeaders.Set("User-Agent", c.s.userAgent())
-	reqHeaders.Set("Accept", "application/json, text/javascript, */*; q=0.01")
-	// 無需設定 http.Transport 已自帶，並自動解碼，若加上會產生亂碼
-	// reqHeaders.Set("Accept-Encoding", "gzip, deflate, br")
-	reqHeaders.Set("Accept-Language", "zh-TW,zh;q=0.9,en-US;q=0.8,en;q=0.7")
-
-	var body io.Reader = nil
-	urls := ResolveRelative(c.s.host, "/v8/finance/chart", c.symbol)
-	urls += "?" + c.urlParams.Encode()
-	req, err := http.NewRequest("GET", urls, body)
-	if err != nil {
-		return nil, errors.Wrapf(err, "http.NewRequest")
-	}
-	req.Header = reqHeaders
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *RegularMarketPriceCall) Context(ctx context.Context) *RegularMarketPriceCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *RegularMarketPriceCall) SetHeader(key, value string) *RegularMarketPriceCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *RegularMarketPriceCall) Retry(policy *RetryPolicy) *RegularMarketPriceCall {
+	c.retry = policy
+	return c
+}
 
-	return SendRequest(c.ctx, c.s.client, req)
+// DoContext send request with ctx
+func (c *RegularMarketPriceCall) DoContext(ctx context.Context) (*Infomation, error) {
+	return c.Context(ctx).Do()
+}
+
+func (c *RegularMarketPriceCall) doRequest() (*http.Response, error) {
+	return c.execute("/v8/finance/chart", c.symbol)
 }
 
 // Do send request
 func (c *RegularMarketPriceCall) Do() (*Infomation, error) {
 	res, err := c.doRequest()
-	if res != nil && res.StatusCode == http.StatusNotModified {
-		if res.Body != nil {
-			res.Body.Close()
-		}
-		return nil, &Error{
-			Code:   res.StatusCode,
-			Header: res.Header,
-		}
+	ret := &Infomation{}
+	if ret.ServerResponse, err = readResponse(ret, res, err); err != nil {
+		return nil, err
 	}
-	if err != nil {
-		return nil, errors.Wrapf(err, "doRequest")
-	}
-	defer res.Body.Close()
-	if err := CheckResponse(res); err != nil {
-		return nil, errors.Wrapf(err, "CheckResponse")
+	if err := ret.validate(false); err != nil {
+		return nil, err
 	}
 
-	ret := &Infomation{
-		ServerResponse: ServerResponse{
-			Header:         res.Header,
-			HTTPStatusCode: res.StatusCode,
+	return ret, nil
+}
+
+// Symbols get quotes of symbols in one request
+// https://query1.finance.yahoo.com/v7/finance/quote?symbols=0050.TW,VTI
+func (r *QuoteService) Symbols(symbols ...string) *SymbolsCall {
+	c := &SymbolsCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
 		},
 	}
-	target := &ret
-	if err := DecodeResponse(target, res); err != nil {
-		return nil, errors.Wrapf(err, "DecodeResponse")
+
+	c.urlParams.Set("symbols", strings.Join(symbols, ","))
+
+	return c
+}
+
+// SymbolsCall call function
+type SymbolsCall struct {
+	DefaultCall
+}
+
+// Fields only return the fields, e.g. regularMarketPrice,bid,ask
+// Default is all fields
+func (c *SymbolsCall) Fields(fields ...string) *SymbolsCall {
+	c.urlParams.Set("fields", strings.Join(fields, ","))
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *SymbolsCall) Context(ctx context.Context) *SymbolsCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *SymbolsCall) SetHeader(key, value string) *SymbolsCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *SymbolsCall) Retry(policy *RetryPolicy) *SymbolsCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send request with ctx
+func (c *SymbolsCall) DoContext(ctx context.Context) (*QuoteInfomation, error) {
+	return c.Context(ctx).Do()
+}
+
+func (c *SymbolsCall) doRequest() (*http.Response, error) {
+	return c.execute("/v7/finance/quote")
+}
+
+// Do send request
+func (c *SymbolsCall) Do() (*QuoteInfomation, error) {
+	res, err := c.doRequest()
+	ret := &QuoteInfomation{}
+	if ret.ServerResponse, err = readResponse(ret, res, err); err != nil {
+		return nil, err
 	}
 
 	return ret, nil
@@ -211,15 +211,15 @@ func TestRegularMarketPriceCall_Do(t *testing.T) {
 						Indicators: Indicators{
 							Quote: []Quote{
 								{
-									Volume: []float64{1067400, 282600, 4401400},
-									Close:  []float64{55.665000915527344, 55.310001373291016, 191.50999450683594},
-									Open:   []float64{55.42499923706055, 55.814998626708984, 190},
-									High:   []float64{56.005001068115234, 55.915000915527344, 191.50999450683594},
-									Low:    []float64{55.17499923706055, 55.310001373291016, 189.99000549316406},
+									Volume: nullFloats(1067400, 282600, 4401400),
+									Close:  nullFloats(55.665000915527344, 55.310001373291016, 191.50999450683594),
+									Open:   nullFloats(55.42499923706055, 55.814998626708984, 190),
+									High:   nullFloats(56.005001068115234, 55.915000915527344, 191.50999450683594),
+									Low:    nullFloats(55.17499923706055, 55.310001373291016, 189.99000549316406),
 								},
 							},
 							Adjclose: []Adjclose{
-								{[]float64{38.816429138183594, 38.568904876708984, 191.50999450683594}},
+								{nullFloats(38.816429138183594, 38.568904876708984, 191.50999450683594)},
 							},
 						},
 					}},
@@ -241,3 +241,131 @@ func TestRegularMarketPriceCall_Do(t *testing.T) {
 		})
 	}
 }
+
+func TestSymbolsCall_doRequest(t *testing.T) {
+	client := clientTest("", http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *SymbolsCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewQuoteService(yfinanceTest).Symbols("0050.TW", "VTI"),
+			"https://query1.finance.yahoo.com/v7/finance/quote?symbols=0050.TW%2CVTI", false},
+		{"Fields", NewQuoteService(yfinanceTest).Symbols("VTI").Fields("bid", "ask"),
+			"https://query1.finance.yahoo.com/v7/finance/quote?fields=bid%2Cask&symbols=VTI", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.doRequest()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SymbolsCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.Request.URL.String()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SymbolsCall.doRequest() = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSymbolsCall_Do(t *testing.T) {
+	str := `{
+		"quoteResponse": {
+		  "result": [
+			{
+			  "language": "en-US",
+			  "region": "US",
+			  "quoteType": "ETF",
+			  "currency": "USD",
+			  "exchange": "PCX",
+			  "shortName": "Vanguard Total Stock Market ETF",
+			  "longName": "Vanguard Total Stock Market Index Fund",
+			  "marketState": "POST",
+			  "regularMarketPrice": 191.3,
+			  "regularMarketTime": 1607374800,
+			  "regularMarketDayRange": "190.37 - 191.74",
+			  "regularMarketVolume": 2998419,
+			  "bid": 191.2,
+			  "ask": 191.45,
+			  "bidSize": 8,
+			  "askSize": 10,
+			  "fiftyTwoWeekLow": 116.71,
+			  "fiftyTwoWeekHigh": 192.08,
+			  "fiftyTwoWeekRange": "116.71 - 192.08",
+			  "averageDailyVolume3Month": 3735126,
+			  "marketCap": 1170574541000,
+			  "postMarketPrice": 191.3,
+			  "postMarketTime": 1607381999,
+			  "exchangeTimezoneName": "America/New_York",
+			  "symbol": "VTI"
+			}
+		  ],
+		  "error": null
+		}
+	  }`
+	client := clientTest(str, http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *SymbolsCall
+		want    *QuoteInfomation
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewQuoteService(yfinanceTest).Symbols("VTI"), &QuoteInfomation{
+			ServerResponse: ServerResponse{
+				HTTPStatusCode: 200,
+				Header:         map[string][]string{},
+			},
+			QuoteResponse: QuoteResponse{
+				Result: []QuoteResult{
+					{
+						Symbol:                   "VTI",
+						ShortName:                "Vanguard Total Stock Market ETF",
+						LongName:                 "Vanguard Total Stock Market Index Fund",
+						QuoteType:                "ETF",
+						Currency:                 "USD",
+						Exchange:                 "PCX",
+						ExchangeTimezoneName:     "America/New_York",
+						MarketState:              "POST",
+						RegularMarketPrice:       191.3,
+						RegularMarketTime:        1607374800,
+						RegularMarketDayRange:    "190.37 - 191.74",
+						RegularMarketVolume:      2998419,
+						Bid:                      191.2,
+						Ask:                      191.45,
+						BidSize:                  8,
+						AskSize:                  10,
+						FiftyTwoWeekLow:          116.71,
+						FiftyTwoWeekHigh:         192.08,
+						FiftyTwoWeekRange:        "116.71 - 192.08",
+						AverageDailyVolume3Month: 3735126,
+						MarketCap:                1170574541000,
+						PostMarketPrice:          191.3,
+						PostMarketTime:           1607381999,
+					},
+				},
+				Error: ErrorHistory{},
+			},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SymbolsCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SymbolsCall.Do() = \n%+v, \nwant \n%+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package yahoofinance
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter blocks until req is allowed to be sent
+type RateLimiter interface {
+	// Wait returns ctx.Err() if ctx is done before req is allowed
+	Wait(ctx context.Context, req *http.Request) error
+}
+
+// NewTokenBucket allows rate requests per second on average
+// and at most burst requests at once, rate <= 0 is unlimited
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// TokenBucket token bucket rate limiter
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// reserve takes a token and returns how long to wait for it
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate <= 0 {
+		return 0
+	}
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a reserved token
+func (b *TokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+}
+
+// Wait implements RateLimiter
+func (b *TokenBucket) Wait(ctx context.Context, req *http.Request) error {
+	d := b.reserve()
+	if d == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+// NewHostLimiter gives every host its own token bucket, rate <= 0 is unlimited
+func NewHostLimiter(rate float64, burst int) *HostLimiter {
+	return &HostLimiter{
+		rate:  rate,
+		burst: burst,
+		hosts: make(map[string]*TokenBucket),
+	}
+}
+
+// HostLimiter limits each host separately
+// and the endpoints added by Endpoint additionally
+type HostLimiter struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     int
+	hosts     map[string]*TokenBucket
+	endpoints []endpointLimiter
+}
+
+type endpointLimiter struct {
+	prefix  string
+	limiter RateLimiter
+}
+
+// Endpoint limits requests whose path starts with prefix, e.g. /v7/finance/quote,
+// on top of the host limit
+func (l *HostLimiter) Endpoint(prefix string, limiter RateLimiter) *HostLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.endpoints = append(l.endpoints, endpointLimiter{prefix: prefix, limiter: limiter})
+	return l
+}
+
+// host returns the bucket of host, creating it if needed
+func (l *HostLimiter) host(host string) *TokenBucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.hosts[host]
+	if !ok {
+		b = NewTokenBucket(l.rate, l.burst)
+		l.hosts[host] = b
+	}
+	return b
+}
+
+// Wait implements RateLimiter
+// The endpoints are waited for first, so a host token is not taken
+// by a request canceled while waiting for its endpoint.
+func (l *HostLimiter) Wait(ctx context.Context, req *http.Request) error {
+	l.mu.Lock()
+	endpoints := l.endpoints
+	l.mu.Unlock()
+	for _, e := range endpoints {
+		if !strings.HasPrefix(req.URL.Path, e.prefix) {
+			continue
+		}
+		if err := e.limiter.Wait(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	return l.host(req.URL.Host).Wait(ctx, req)
+}
+
+// rateLimit middleware waits for limiter before sending
+func rateLimit(limiter RateLimiter) Middleware {
+	return BeforeSend(func(req *http.Request) error {
+		return limiter.Wait(req.Context(), req)
+	})
+}
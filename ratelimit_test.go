@@ -0,0 +1,133 @@
+package yahoofinance
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+type countLimiter struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+func (l *countLimiter) Wait(ctx context.Context, req *http.Request) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.paths = append(l.paths, req.URL.Path)
+	return nil
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://query1.finance.yahoo.com/v8/finance/chart/VTI", nil)
+
+	tests := []struct {
+		name     string
+		b        *TokenBucket
+		n        int
+		wantWait time.Duration
+	}{
+		// TODO: Add test cases.
+		{"Burst", NewTokenBucket(1, 3), 3, 0},
+		{"Wait", NewTokenBucket(20, 1), 3, 100 * time.Millisecond},
+		{"Unlimited", NewTokenBucket(0, 1), 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			for i := 0; i < tt.n; i++ {
+				if err := tt.b.Wait(context.Background(), req); err != nil {
+					t.Fatalf("TokenBucket.Wait() error = %v", err)
+				}
+			}
+			got := time.Since(start)
+			if got < tt.wantWait-10*time.Millisecond || got > tt.wantWait+80*time.Millisecond {
+				t.Errorf("TokenBucket.Wait() waited %v, want %v", got, tt.wantWait)
+			}
+		})
+	}
+}
+
+func TestTokenBucket_Wait_Canceled(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://query1.finance.yahoo.com/v8/finance/chart/VTI", nil)
+	b := NewTokenBucket(0.1, 1)
+	if err := b.Wait(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Wait(ctx, req); err != context.DeadlineExceeded {
+		t.Errorf("TokenBucket.Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestHostLimiter_Wait(t *testing.T) {
+	query1, _ := http.NewRequest("GET", "https://query1.finance.yahoo.com/v8/finance/chart/VTI", nil)
+	query2, _ := http.NewRequest("GET", "https://query2.finance.yahoo.com/v8/finance/chart/VTI", nil)
+	quote, _ := http.NewRequest("GET", "https://query2.finance.yahoo.com/v7/finance/quote?symbols=VTI", nil)
+
+	endpoint := &countLimiter{}
+	l := NewHostLimiter(0.1, 1).Endpoint("/v7/finance/quote", endpoint)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, query1); err != nil {
+		t.Fatalf("HostLimiter.Wait() query1 error = %v", err)
+	}
+	if err := l.Wait(ctx, query2); err != nil {
+		t.Fatalf("HostLimiter.Wait() query2 error = %v", err)
+	}
+	if err := l.Wait(ctx, query1); err != context.DeadlineExceeded {
+		t.Errorf("HostLimiter.Wait() query1 error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if len(endpoint.paths) != 0 {
+		t.Errorf("HostLimiter.Wait() endpoint waited for %v", endpoint.paths)
+	}
+
+	l = NewHostLimiter(10, 10).Endpoint("/v7/finance/quote", endpoint)
+	if err := l.Wait(context.Background(), quote); err != nil {
+		t.Fatalf("HostLimiter.Wait() quote error = %v", err)
+	}
+	if len(endpoint.paths) != 1 {
+		t.Errorf("HostLimiter.Wait() endpoint waited for %v, want 1 request", endpoint.paths)
+	}
+
+	// a request canceled while waiting for its endpoint leaves the host token
+	l = NewHostLimiter(0.1, 2).Endpoint("/v7/finance/quote", NewTokenBucket(0.1, 1))
+	if err := l.Wait(context.Background(), quote); err != nil {
+		t.Fatalf("HostLimiter.Wait() quote error = %v", err)
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, quote); err != context.DeadlineExceeded {
+		t.Errorf("HostLimiter.Wait() quote error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, query2); err != nil {
+		t.Errorf("HostLimiter.Wait() query2 error = %v", err)
+	}
+}
+
+func TestService_SetRateLimiter(t *testing.T) {
+	client := clientTest(`{"chart":{"result":[{"meta":{"symbol":"VTI"}}],"error":null}}`, http.StatusOK)
+	yfinanceTest, _ := New(client)
+	limiter := &countLimiter{}
+	yfinanceTest.SetRateLimiter(limiter)
+
+	if _, err := yfinanceTest.History.Period("VTI", "1mo", "1d").Do(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := yfinanceTest.Quote.Symbols("VTI").Do(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"/v8/finance/chart/VTI", "/v7/finance/quote"}
+	if len(limiter.paths) != len(want) || limiter.paths[0] != want[0] || limiter.paths[1] != want[1] {
+		t.Errorf("RateLimiter.Wait() called for %v, want %v", limiter.paths, want)
+	}
+}
@@ -0,0 +1,230 @@
+package yahoofinance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Frequency buckets of Resample
+type Frequency struct {
+	every   time.Duration
+	weekEnd time.Weekday
+	months  int
+}
+
+// Every buckets of d up to a day, anchored at the open and close of the session,
+// e.g. 4h buckets of NYSE are 09:30-13:30 and 13:30-16:00; a day is the calendar day
+func Every(d time.Duration) Frequency {
+	return Frequency{every: d}
+}
+
+// Weekly calendar weeks ending on end, e.g. time.Friday
+func Weekly(end time.Weekday) Frequency {
+	return Frequency{every: 7 * day, weekEnd: end}
+}
+
+// Monthly calendar months
+func Monthly() Frequency {
+	return Frequency{months: 1}
+}
+
+// Quarterly calendar quarters
+func Quarterly() Frequency {
+	return Frequency{months: 3}
+}
+
+// String e.g. 5m, 4h, 1d, 1wk-fri, 1mo or 3mo
+func (f Frequency) String() string {
+	switch {
+	case f.months > 0:
+		return fmt.Sprintf("%dmo", f.months)
+	case f.every == 7*day:
+		return "1wk-" + strings.ToLower(f.weekEnd.String()[:3])
+	case f.every == day:
+		return "1d"
+	case f.every > 0 && f.every%time.Hour == 0:
+		return fmt.Sprintf("%dh", f.every/time.Hour)
+	case f.every > 0 && f.every%time.Minute == 0:
+		return fmt.Sprintf("%dm", f.every/time.Minute)
+	}
+	return f.every.String()
+}
+
+// intraday reports whether buckets are shorter than a day
+func (f Frequency) intraday() bool {
+	return f.months == 0 && f.every < day
+}
+
+// valid reports whether f is one of Every, Weekly, Monthly or Quarterly
+func (f Frequency) valid() bool {
+	return f.months > 0 || f.every == 7*day || (f.every > 0 && f.every <= day)
+}
+
+// ResampleOptions session and partial buckets of Resample, the zero value uses the defaults
+type ResampleOptions struct {
+	// SessionOpen and SessionClose are times of day of the regular session in
+	// the exchange's location, e.g. 9*time.Hour + 30*time.Minute.
+	// Default is Meta.CurrentTradingPeriod.Regular, or the whole day if absent.
+	SessionOpen, SessionClose time.Duration
+	// DropPartial drops the last bucket if it has not ended by Now,
+	// e.g. this week or the running 5m bucket.
+	DropPartial bool
+	// Now Default is time.Now
+	Now time.Time
+}
+
+// session open and close of r
+func (o *ResampleOptions) session(r *Result) (time.Duration, time.Duration) {
+	if o != nil && (o.SessionOpen != 0 || o.SessionClose != 0) {
+		return o.SessionOpen, o.SessionClose
+	}
+
+	regular := r.Meta.CurrentTradingPeriod.Regular
+	if regular.Start == 0 && regular.End == 0 {
+		return 0, day
+	}
+	loc := r.Meta.Location()
+	clock := func(ts int64) time.Duration {
+		t := time.Unix(ts, 0).In(loc)
+		return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	}
+	return clock(regular.Start), clock(regular.End)
+}
+
+func (o *ResampleOptions) now() time.Time {
+	if o != nil && !o.Now.IsZero() {
+		return o.Now
+	}
+	return time.Now()
+}
+
+// bucket start and end of the one holding t, in the location of t
+func (f Frequency) bucket(t time.Time, open, close time.Duration) (time.Time, time.Time) {
+	y, m, d := t.Date()
+	loc := t.Location()
+	clock := func(offset time.Duration) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, int(offset), loc)
+	}
+
+	switch {
+	case f.months > 0:
+		start := time.Date(y, m-(m-1)%time.Month(f.months), 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, f.months, 0)
+	case f.every == 7*day:
+		start := clock(0).AddDate(0, 0, -int((t.Weekday()-f.weekEnd+6)%7))
+		return start, start.AddDate(0, 0, 7)
+	case f.every == day:
+		return clock(0), clock(0).AddDate(0, 0, 1)
+	}
+
+	// buckets before the open are anchored backward from it and never span it,
+	// the ones after it are anchored at it and end at the close, and so on
+	midnight, next := clock(0), clock(0).AddDate(0, 0, 1)
+	anchor, lo, hi := clock(open), midnight, clock(open)
+	if close <= open {
+		anchor, lo, hi = midnight, midnight, next
+	} else if !t.Before(clock(close)) {
+		anchor, lo, hi = clock(close), clock(close), next
+	} else if !t.Before(clock(open)) {
+		lo, hi = clock(open), clock(close)
+	}
+
+	k := t.Sub(anchor) / f.every
+	if t.Sub(anchor)%f.every < 0 {
+		k--
+	}
+	start, end := anchor.Add(k*f.every), anchor.Add((k+1)*f.every)
+	if start.Before(lo) {
+		start = lo
+	}
+	if end.After(hi) {
+		end = hi
+	}
+	return start, end
+}
+
+// Resample aggregates the bars of r into buckets of f in the exchange's location:
+// the first open, the highest high, the lowest low, the last close and adjclose
+// and the total volume, ignoring nulls. A bucket is timestamped by its start and
+// buckets without bars are skipped. Intraday buckets never span the open or
+// close of a session or midnight, so the last one of a session may be shorter.
+// The first bucket only covers the bars in r, e.g. a month from its middle.
+// Timestamps of r must be ascending and f must not be finer than its interval.
+func (r *Result) Resample(f Frequency, opts *ResampleOptions) (*Result, error) {
+	if !f.valid() {
+		return nil, errors.Errorf("invalid frequency %s", f)
+	}
+	if src := Interval(r.Meta.DataGranularity).Duration(); f.intraday() && src > f.every {
+		return nil, errors.Errorf("frequency %s finer than interval %s", f, r.Meta.DataGranularity)
+	}
+	q, adjclose, err := r.series()
+	if err != nil {
+		return nil, errors.Wrapf(err, "series")
+	}
+
+	c := &Result{Meta: r.Meta, Events: r.Events}
+	c.Meta.DataGranularity = f.String()
+	if q == nil {
+		return c, nil
+	}
+
+	cq := Quote{}
+	var cadj []NullFloat64
+	loc := r.Meta.Location()
+	open, close := opts.session(r)
+	var end time.Time
+	for i, ts := range r.Timestamp {
+		if i > 0 && ts < r.Timestamp[i-1] {
+			return nil, errors.Errorf("timestamp %d before %d", ts, r.Timestamp[i-1])
+		}
+
+		t := time.Unix(ts, 0).In(loc)
+		if i == 0 || !t.Before(end) {
+			var start time.Time
+			start, end = f.bucket(t, open, close)
+			c.Timestamp = append(c.Timestamp, start.Unix())
+			cq.Open = append(cq.Open, NullFloat64{})
+			cq.High = append(cq.High, NullFloat64{})
+			cq.Low = append(cq.Low, NullFloat64{})
+			cq.Close = append(cq.Close, NullFloat64{})
+			cq.Volume = append(cq.Volume, NullFloat64{})
+			cadj = append(cadj, NullFloat64{})
+		}
+
+		n := len(c.Timestamp) - 1
+		if !cq.Open[n].Valid {
+			cq.Open[n] = q.Open[i]
+		}
+		if v := q.High[i]; v.Valid && (!cq.High[n].Valid || v.Float64 > cq.High[n].Float64) {
+			cq.High[n] = v
+		}
+		if v := q.Low[i]; v.Valid && (!cq.Low[n].Valid || v.Float64 < cq.Low[n].Float64) {
+			cq.Low[n] = v
+		}
+		if q.Close[i].Valid {
+			cq.Close[n] = q.Close[i]
+		}
+		if v := q.Volume[i]; v.Valid {
+			cq.Volume[n] = NullFloat64{Float64: cq.Volume[n].Float64 + v.Float64, Valid: true}
+		}
+		if adjclose != nil && adjclose[i].Valid {
+			cadj[n] = adjclose[i]
+		}
+	}
+
+	if opts != nil && opts.DropPartial && end.After(opts.now()) {
+		n := len(c.Timestamp) - 1
+		c.Timestamp = c.Timestamp[:n]
+		cq = Quote{Volume: cq.Volume[:n], Close: cq.Close[:n], Open: cq.Open[:n], High: cq.High[:n], Low: cq.Low[:n]}
+		cadj = cadj[:n]
+	}
+	c.Indicators.Quote = []Quote{cq}
+	if adjclose != nil {
+		c.Indicators.Adjclose = []Adjclose{{Value: cadj}}
+	}
+
+	return c, nil
+}
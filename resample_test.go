@@ -0,0 +1,145 @@
+package yahoofinance
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFrequency_String(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Frequency
+		want string
+	}{
+		// TODO: Add test cases.
+		{"Minute", Every(5 * time.Minute), "5m"},
+		{"Hour", Every(4 * time.Hour), "4h"},
+		{"Day", Every(24 * time.Hour), "1d"},
+		{"Week", Weekly(time.Friday), "1wk-fri"},
+		{"Month", Monthly(), "1mo"},
+		{"Quarter", Quarterly(), "3mo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.String(); got != tt.want {
+				t.Errorf("Frequency.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Resample(t *testing.T) {
+	nan := math.NaN()
+	newYork := Meta{ExchangeTimezoneName: "America/New_York", DataGranularity: "1m"}
+	session := newYork
+	session.CurrentTradingPeriod.Regular = TimeInfo{Start: 1607092200, End: 1607115600}
+	// 09:28, 09:30, 09:31, 09:34, 09:35, 15:59 and 16:00 of 2020-12-04
+	minutes := []int64{1607092080, 1607092200, 1607092260, 1607092440, 1607092500, 1607115540, 1607115600}
+
+	// Thu 2020-12-03, Fri 12-04, Mon 12-07, Fri 12-11 and Mon 12-14 at 09:30
+	daily := func() *Result {
+		r := testResult(Meta{ExchangeTimezoneName: "America/New_York", DataGranularity: "1d"},
+			[]int64{1607005800, 1607092200, 1607351400, 1607697000, 1607956200}, 1, 1, 2, nan, 4, 5)
+		r.Indicators.Adjclose = []Adjclose{{nullFloats(0.9, 1.9, nan, 3.9, 4.9)}}
+		return r
+	}
+	total := func(granularity string, ts int64) *Result {
+		return &Result{
+			Meta:      Meta{ExchangeTimezoneName: "America/New_York", DataGranularity: granularity},
+			Timestamp: []int64{ts},
+			Indicators: Indicators{
+				Quote: []Quote{{
+					Volume: nullFloats(1200), Close: nullFloats(5), Open: nullFloats(1), High: nullFloats(6), Low: nullFloats(0),
+				}},
+				Adjclose: []Adjclose{{nullFloats(4.9)}},
+			},
+		}
+	}
+	weekly := &Result{
+		Meta:      Meta{ExchangeTimezoneName: "America/New_York", DataGranularity: "1wk-fri"},
+		Timestamp: []int64{1606539600, 1607144400, 1607749200},
+		Indicators: Indicators{
+			Quote: []Quote{{
+				Volume: nullFloats(300, 400, 500),
+				Close:  nullFloats(2, 4, 5),
+				Open:   nullFloats(1, 4, 5),
+				High:   nullFloats(3, 5, 6),
+				Low:    nullFloats(0, 3, 4),
+			}},
+			Adjclose: []Adjclose{{nullFloats(1.9, 3.9, 4.9)}},
+		},
+	}
+	partial := weekly.clone()
+	partial.Timestamp = partial.Timestamp[:2]
+	q := &partial.Indicators.Quote[0]
+	q.Volume, q.Close, q.Open, q.High, q.Low = q.Volume[:2], q.Close[:2], q.Open[:2], q.High[:2], q.Low[:2]
+	partial.Indicators.Adjclose[0].Value = partial.Indicators.Adjclose[0].Value[:2]
+
+	fiveMinutes := &Result{
+		Meta:      session,
+		Timestamp: []int64{1607091900, 1607092200, 1607092500, 1607115300, 1607115600},
+		Indicators: Indicators{
+			Quote: []Quote{{
+				Volume: nullFloats(100, 900, 500, 600, 700),
+				Close:  nullFloats(1, 4, 5, 6, 7),
+				Open:   nullFloats(1, 2, 5, 6, 7),
+				High:   nullFloats(2, 5, 6, 7, 8),
+				Low:    nullFloats(0, 1, 4, 5, 6),
+			}},
+			Adjclose: []Adjclose{{nullFloats(1, 4, 5, 6, 7)}},
+		},
+	}
+	fiveMinutes.Meta.DataGranularity = "5m"
+	fourHours := &Result{
+		Meta:      newYork,
+		Timestamp: []int64{1607077800, 1607092200, 1607106600, 1607115600},
+		Indicators: Indicators{
+			Quote: []Quote{{
+				Volume: nullFloats(100, 1400, 600, 700),
+				Close:  nullFloats(1, 5, 6, 7),
+				Open:   nullFloats(1, 2, 6, 7),
+				High:   nullFloats(2, 6, 7, 8),
+				Low:    nullFloats(0, 1, 5, 6),
+			}},
+			Adjclose: []Adjclose{{nullFloats(1, 5, 6, 7)}},
+		},
+	}
+	fourHours.Meta.DataGranularity = "4h"
+
+	tests := []struct {
+		name    string
+		r       *Result
+		f       Frequency
+		opts    *ResampleOptions
+		want    *Result
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Minutes", testResult(session, minutes, 1, 1, 2, 3, 4, 5, 6, 7), Every(5 * time.Minute), nil, fiveMinutes, false},
+		{"Hours", testResult(newYork, minutes, 1, 1, 2, 3, 4, 5, 6, 7), Every(4 * time.Hour),
+			&ResampleOptions{SessionOpen: 9*time.Hour + 30*time.Minute, SessionClose: 16 * time.Hour}, fourHours, false},
+		{"Weekly", daily(), Weekly(time.Friday), nil, weekly, false},
+		{"Partial", daily(), Weekly(time.Friday), &ResampleOptions{DropPartial: true, Now: time.Unix(1608000000, 0)}, partial, false},
+		{"Complete", daily(), Weekly(time.Friday), &ResampleOptions{DropPartial: true, Now: time.Unix(1608400000, 0)}, weekly, false},
+		{"Monthly", daily(), Monthly(), nil, total("1mo", 1606798800), false},
+		{"Quarterly", daily(), Quarterly(), nil, total("3mo", 1601524800), false},
+		{"Empty", &Result{}, Monthly(), nil, &Result{Meta: Meta{DataGranularity: "1mo"}}, false},
+		{"Finer", daily(), Every(5 * time.Minute), nil, nil, true},
+		{"Invalid", daily(), Every(0), nil, nil, true},
+		{"Unsorted", testResult(newYork, []int64{120, 60}, 1, 1, 2), Every(5 * time.Minute), nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.r.Resample(tt.f, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Result.Resample() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Result.Resample() = \n%+v, want \n%+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package yahoofinance
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultRetryPolicy retries up to 3 times, waiting about 0.5s, 1s and 2s
+var DefaultRetryPolicy = &RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// RetryPolicy retries GET requests failed by 429, 5xx, connection resets or timeouts
+// with exponential backoff and jitter
+type RetryPolicy struct {
+	// MaxAttempts includes the first attempt, 1 or less disables retry
+	MaxAttempts int
+	// BaseDelay is the delay before the second attempt, doubled for every next attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the delay, including Retry-After, 0 means no cap
+	MaxDelay time.Duration
+}
+
+// attempts max attempts of req
+func (p *RetryPolicy) attempts(req *http.Request) int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// delay before attempt+1, the larger of backoff and Retry-After
+func (p *RetryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	d := p.BaseDelay << uint(attempt-1)
+	if d < 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+	// full jitter in [d/2, d]
+	if d > 1 {
+		d = d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+	}
+
+	if res != nil {
+		if after, ok := retryAfter(res.Header.Get("Retry-After")); ok && after > d {
+			d = after
+		}
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	return d
+}
+
+// retryAfter parses Retry-After in seconds or HTTP date
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+// retryable reports whether the attempt failed transiently
+func retryable(res *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNABORTED) ||
+			errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return true
+		}
+		var netErr net.Error
+		return errors.As(err, &netErr) && netErr.Timeout()
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// sleep waits d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retry middleware retries by policy
+// A failure after retries, a non-2xx response or an error without response,
+// is returned as *Error with the attempt count
+func retry(policy *RetryPolicy) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			attempts := policy.attempts(req)
+			for attempt := 1; ; attempt++ {
+				res, err := next(req)
+				if attempt >= attempts || !retryable(res, err) {
+					if attempt == 1 {
+						return res, err
+					}
+					if err != nil {
+						return nil, &Error{Attempts: attempt, Err: err}
+					}
+					if res.StatusCode >= 300 && res.StatusCode != http.StatusNotModified {
+						defer res.Body.Close()
+						err := CheckResponse(res)
+						if e, ok := err.(*Error); ok {
+							e.Attempts = attempt
+							return nil, e
+						}
+						return nil, err
+					}
+					return res, nil
+				}
+
+				delay := policy.delay(attempt, res)
+				if res != nil {
+					io.Copy(ioutil.Discard, res.Body)
+					res.Body.Close()
+				}
+				if err := sleep(req.Context(), delay); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package yahoofinance
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type reply struct {
+	statusCode int
+	header     http.Header
+	err        error
+}
+
+type SeqTransport struct {
+	replies []reply
+	count   int
+}
+
+// RoundTrip reply in sequence, the last reply repeats
+func (t *SeqTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := t.replies[len(t.replies)-1]
+	if t.count < len(t.replies) {
+		r = t.replies[t.count]
+	}
+	t.count++
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	var res http.Response
+	res.StatusCode = r.statusCode
+	res.Body = ioutil.NopCloser(strings.NewReader(`{"chart":{"result":[{"meta":{"symbol":"VTI"}}],"error":null}}`))
+	res.Header = r.header
+	if res.Header == nil {
+		res.Header = http.Header{}
+	}
+	res.Request = req
+
+	return &res, nil
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 50 * time.Millisecond}
+
+	tests := []struct {
+		name         string
+		replies      []reply
+		call         func(s *Service) error
+		wantCount    int
+		wantErr      bool
+		wantAttempts int
+		wantCode     int
+	}{
+		// TODO: Add test cases.
+		{"Success", []reply{{statusCode: 503}, {statusCode: 429, header: http.Header{"Retry-After": []string{"0"}}}, {statusCode: 200}},
+			func(s *Service) error { _, err := s.History.Period("VTI", "1mo", "1d").Do(); return err }, 3, false, 0, 0},
+		{"Reset", []reply{{err: syscall.ECONNRESET}, {statusCode: 200}},
+			func(s *Service) error { _, err := s.Quote.Symbols("VTI").Do(); return err }, 2, false, 0, 0},
+		{"ResetExhausted", []reply{{err: syscall.ECONNRESET}},
+			func(s *Service) error { _, err := s.Quote.Symbols("VTI").Do(); return err }, 3, true, 3, 0},
+		{"Exhausted", []reply{{statusCode: 500}},
+			func(s *Service) error { _, err := s.History.Period("VTI", "1mo", "1d").Do(); return err }, 3, true, 3, 500},
+		{"NotRetryable", []reply{{statusCode: 404}},
+			func(s *Service) error { _, err := s.History.Period("VTI", "1mo", "1d").Do(); return err }, 1, true, 0, 0},
+		{"Override", []reply{{statusCode: 500}},
+			func(s *Service) error {
+				_, err := s.History.Period("VTI", "1mo", "1d").Retry(&RetryPolicy{}).Do()
+				return err
+			}, 1, true, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &SeqTransport{replies: tt.replies}
+			yfinanceTest, _ := New(&http.Client{Transport: transport})
+			yfinanceTest.SetRetryPolicy(policy)
+
+			err := tt.call(yfinanceTest)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if transport.count != tt.wantCount {
+				t.Errorf("Do() sent %d requests, want %d", transport.count, tt.wantCount)
+			}
+			if tt.wantAttempts > 0 {
+				var e *Error
+				if !errors.As(err, &e) {
+					t.Fatalf("Do() error = %T, want *Error", errors.Cause(err))
+				}
+				if e.Attempts != tt.wantAttempts || e.Code != tt.wantCode {
+					t.Errorf("Error = %+v, want %d attempts and code %d", e, tt.wantAttempts, tt.wantCode)
+				}
+				if tt.wantCode == 0 && !errors.Is(err, syscall.ECONNRESET) {
+					t.Errorf("Error = %v, want cause %v", err, syscall.ECONNRESET)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	tests := []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		min, max   time.Duration
+	}{
+		// TODO: Add test cases.
+		{"First", 1, "", 50 * time.Millisecond, 100 * time.Millisecond},
+		{"Second", 2, "", 100 * time.Millisecond, 200 * time.Millisecond},
+		{"Capped", 4, "", 150 * time.Millisecond, 300 * time.Millisecond},
+		{"RetryAfter", 1, "0", 50 * time.Millisecond, 100 * time.Millisecond},
+		{"RetryAfterCapped", 1, "120", 300 * time.Millisecond, 300 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				res.Header.Set("Retry-After", tt.retryAfter)
+			}
+			got := policy.delay(tt.attempt, res)
+			if got < tt.min || got > tt.max {
+				t.Errorf("RetryPolicy.delay() = %v, want in [%v, %v]", got, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package yahoofinance
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// NewSearchService search symbols
+func NewSearchService(s *Service) *SearchService {
+	rs := &SearchService{s: s}
+	return rs
+}
+
+// SearchService search symbols
+type SearchService struct {
+	s *Service
+}
+
+// Query search symbols and news by name
+// https://query1.finance.yahoo.com/v1/finance/search?q=vanguard+total&quotesCount=6&newsCount=4&enableFuzzyQuery=false
+func (r *SearchService) Query(q string) *QueryCall {
+	c := &QueryCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+	}
+
+	c.urlParams.Set("q", q)
+
+	return c
+}
+
+// QueryCall call function
+type QueryCall struct {
+	DefaultCall
+}
+
+// QuotesCount max number of quotes, Default is 6
+func (c *QueryCall) QuotesCount(n int) *QueryCall {
+	c.urlParams.Set("quotesCount", strconv.Itoa(n))
+	return c
+}
+
+// NewsCount max number of news, Default is 4
+func (c *QueryCall) NewsCount(n int) *QueryCall {
+	c.urlParams.Set("newsCount", strconv.Itoa(n))
+	return c
+}
+
+// Fuzzy match misspelled names, Default is false
+func (c *QueryCall) Fuzzy(enable bool) *QueryCall {
+	c.urlParams.Set("enableFuzzyQuery", strconv.FormatBool(enable))
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *QueryCall) Context(ctx context.Context) *QueryCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *QueryCall) SetHeader(key, value string) *QueryCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *QueryCall) Retry(policy *RetryPolicy) *QueryCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send request with ctx
+func (c *QueryCall) DoContext(ctx context.Context) (*SearchInfomation, error) {
+	return c.Context(ctx).Do()
+}
+
+func (c *QueryCall) doRequest() (*http.Response, error) {
+	return c.execute("/v1/finance/search")
+}
+
+// Do send request
+func (c *QueryCall) Do() (*SearchInfomation, error) {
+	res, err := c.doRequest()
+	ret := &SearchInfomation{}
+	if ret.ServerResponse, err = readResponse(ret, res, err); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,125 @@
+package yahoofinance
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestQueryCall_doRequest(t *testing.T) {
+	client := clientTest("", http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *QueryCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewSearchService(yfinanceTest).Query("taiwan 50"),
+			"https://query1.finance.yahoo.com/v1/finance/search?q=taiwan+50", false},
+		{"Options", NewSearchService(yfinanceTest).Query("vanguard total").QuotesCount(10).NewsCount(0).Fuzzy(true),
+			"https://query1.finance.yahoo.com/v1/finance/search?enableFuzzyQuery=true&newsCount=0&q=vanguard+total&quotesCount=10", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.doRequest()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("QueryCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.Request.URL.String()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("QueryCall.doRequest() = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryCall_Do(t *testing.T) {
+	str := `{
+		"explains": [],
+		"count": 2,
+		"quotes": [
+		  {
+			"exchange": "TAI",
+			"shortname": "YUANTA/P-SHARES TAIWAN TOP 50 E",
+			"quoteType": "ETF",
+			"symbol": "0050.TW",
+			"index": "quotes",
+			"score": 20125,
+			"typeDisp": "ETF",
+			"exchDisp": "Taiwan",
+			"isYahooFinance": true
+		  }
+		],
+		"news": [
+		  {
+			"uuid": "9f5d0a2e-6c35-3b4c-9d1c-2c4f8a5f6e1b",
+			"title": "Taiwan stocks rally",
+			"publisher": "Reuters",
+			"link": "https://finance.yahoo.com/news/taiwan-stocks-rally.html",
+			"providerPublishTime": 1607374800,
+			"type": "STORY"
+		  }
+		],
+		"nav": [],
+		"lists": [],
+		"totalTime": 20
+	  }`
+	client := clientTest(str, http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *QueryCall
+		want    *SearchInfomation
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewSearchService(yfinanceTest).Query("taiwan 50"), &SearchInfomation{
+			ServerResponse: ServerResponse{
+				HTTPStatusCode: 200,
+				Header:         map[string][]string{},
+			},
+			Count: 2,
+			Quotes: []SearchQuote{
+				{
+					Symbol:         "0050.TW",
+					ShortName:      "YUANTA/P-SHARES TAIWAN TOP 50 E",
+					Exchange:       "TAI",
+					ExchDisp:       "Taiwan",
+					QuoteType:      "ETF",
+					TypeDisp:       "ETF",
+					Index:          "quotes",
+					Score:          20125,
+					IsYahooFinance: true,
+				},
+			},
+			News: []SearchNews{
+				{
+					UUID:                "9f5d0a2e-6c35-3b4c-9d1c-2c4f8a5f6e1b",
+					Title:               "Taiwan stocks rally",
+					Publisher:           "Reuters",
+					Link:                "https://finance.yahoo.com/news/taiwan-stocks-rally.html",
+					ProviderPublishTime: 1607374800,
+					Type:                "STORY",
+				},
+			},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("QueryCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("QueryCall.Do() = \n%+v, \nwant \n%+v", got, tt.want)
+			}
+		})
+	}
+}
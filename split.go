@@ -0,0 +1,179 @@
+package yahoofinance
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const day = 24 * time.Hour
+
+// intradayLimit per request window and how far back data is available
+type intradayLimit struct {
+	window   time.Duration
+	lookback time.Duration
+}
+
+// intradayLimits limits of Yahoo for intraday intervals
+var intradayLimits = map[Interval]intradayLimit{
+	Interval1m:  {7 * day, 30 * day},
+	Interval2m:  {60 * day, 60 * day},
+	Interval5m:  {60 * day, 60 * day},
+	Interval15m: {60 * day, 60 * day},
+	Interval30m: {60 * day, 60 * day},
+	Interval90m: {60 * day, 60 * day},
+	Interval60m: {730 * day, 730 * day},
+	Interval1h:  {730 * day, 730 * day},
+}
+
+// validateWindow reports whether Yahoo accepts interval between start and end at now
+func validateWindow(interval Interval, start, end, now time.Time) error {
+	if !interval.Valid() {
+		return errors.Wrapf(ErrInvalidRange, "interval %q is not supported", interval)
+	}
+	if !end.After(start) {
+		return errors.Wrapf(ErrInvalidRange, "end %v is not after start %v", end, start)
+	}
+	if limit, ok := intradayLimits[interval]; ok && start.Before(now.Add(-limit.lookback)) {
+		return errors.Wrapf(ErrInvalidRange, "%s data cannot extend last %d days, start %v", interval, limit.lookback/day, start)
+	}
+
+	return nil
+}
+
+// splitRange splits [start, end) into windows not longer than window
+func splitRange(start, end time.Time, window time.Duration) [][2]time.Time {
+	var ranges [][2]time.Time
+	for s := start; s.Before(end); s = s.Add(window) {
+		e := s.Add(window)
+		if e.After(end) {
+			e = end
+		}
+		ranges = append(ranges, [2]time.Time{s, e})
+	}
+
+	return ranges
+}
+
+// mergeResults stitches the results of one symbol into a series sorted by time,
+// a timestamp in several results keeps the values of the last one
+// Meta is taken from the last result and Events are merged
+func mergeResults(results ...*Result) (*Result, error) {
+	if len(results) == 0 {
+		return nil, errors.New("no result")
+	}
+
+	type row struct {
+		volume, close, open, high, low, adj NullFloat64
+	}
+	rows := map[int64]row{}
+	hasAdj := false
+	merged := &Result{Meta: results[len(results)-1].Meta}
+	for i, r := range results {
+		q, adjclose, err := r.series()
+		if err != nil {
+			return nil, errors.Wrapf(err, "series of result %d", i)
+		}
+		for k, v := range r.Events.Dividends {
+			if merged.Events.Dividends == nil {
+				merged.Events.Dividends = map[string]Dividend{}
+			}
+			merged.Events.Dividends[k] = v
+		}
+		for k, v := range r.Events.Splits {
+			if merged.Events.Splits == nil {
+				merged.Events.Splits = map[string]Split{}
+			}
+			merged.Events.Splits[k] = v
+		}
+		if q == nil {
+			continue
+		}
+
+		hasAdj = hasAdj || adjclose != nil
+		for j, ts := range r.Timestamp {
+			rw := row{volume: q.Volume[j], close: q.Close[j], open: q.Open[j], high: q.High[j], low: q.Low[j]}
+			if adjclose != nil {
+				rw.adj = adjclose[j]
+			}
+			rows[ts] = rw
+		}
+	}
+	if len(rows) == 0 {
+		return merged, nil
+	}
+
+	merged.Timestamp = make([]int64, 0, len(rows))
+	for ts := range rows {
+		merged.Timestamp = append(merged.Timestamp, ts)
+	}
+	sort.Slice(merged.Timestamp, func(i, j int) bool { return merged.Timestamp[i] < merged.Timestamp[j] })
+
+	var q Quote
+	var adj []NullFloat64
+	for _, ts := range merged.Timestamp {
+		rw := rows[ts]
+		q.Volume = append(q.Volume, rw.volume)
+		q.Close = append(q.Close, rw.close)
+		q.Open = append(q.Open, rw.open)
+		q.High = append(q.High, rw.high)
+		q.Low = append(q.Low, rw.low)
+		adj = append(adj, rw.adj)
+	}
+	merged.Indicators.Quote = []Quote{q}
+	if hasAdj {
+		merged.Indicators.Adjclose = []Adjclose{{Value: adj}}
+	}
+
+	return merged, nil
+}
+
+// window returns period1 and period2 of c
+func (c *BetweenCall) window() (time.Time, time.Time, error) {
+	var t [2]time.Time
+	for i, key := range []string{"period1", "period2"} {
+		v, err := strconv.ParseInt(c.urlParams.Get(key), 10, 64)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.Wrapf(err, "%s", key)
+		}
+		t[i] = time.Unix(v, 0)
+	}
+
+	return t[0], t[1], nil
+}
+
+// chunks validates c and splits it into calls within the limits of Yahoo
+func (c *BetweenCall) chunks() ([]*BetweenCall, error) {
+	if c.unchecked {
+		return []*BetweenCall{c}, nil
+	}
+	start, end, err := c.window()
+	if err != nil {
+		return nil, errors.Wrapf(err, "window")
+	}
+	interval := Interval(c.urlParams.Get("interval"))
+	if err := validateWindow(interval, start, end, time.Now()); err != nil {
+		return nil, err
+	}
+
+	limit, ok := intradayLimits[interval]
+	if !ok || end.Sub(start) <= limit.window {
+		return []*BetweenCall{c}, nil
+	}
+
+	var calls []*BetweenCall
+	for _, r := range splitRange(start, end, limit.window) {
+		chunk := &BetweenCall{
+			DefaultCall: c.DefaultCall,
+			symbol:      c.symbol,
+		}
+		chunk.urlParams = cloneValues(c.urlParams)
+		chunk.urlParams.Set("period1", strconv.FormatInt(r[0].Unix(), 10))
+		chunk.urlParams.Set("period2", strconv.FormatInt(r[1].Unix(), 10))
+		calls = append(calls, chunk)
+	}
+
+	return calls, nil
+}
@@ -0,0 +1,202 @@
+package yahoofinance
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func Test_validateWindow(t *testing.T) {
+	now := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		interval Interval
+		start    time.Time
+		end      time.Time
+		wantErr  bool
+	}{
+		// TODO: Add test cases.
+		{"Daily", "1d", time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), now, false},
+		{"1m", "1m", now.Add(-29 * day), now, false},
+		{"1mTooOld", "1m", now.Add(-31 * day), now, true},
+		{"5mTooOld", "5m", now.Add(-61 * day), now, true},
+		{"60m", "60m", now.Add(-700 * day), now, false},
+		{"Interval", "1day", now.Add(-day), now, true},
+		{"Reversed", "1d", now, now.Add(-day), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWindow(tt.interval, tt.start, tt.end, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRange) {
+				t.Errorf("validateWindow() error = %v, want %v", err, ErrInvalidRange)
+			}
+		})
+	}
+}
+
+func Test_splitRange(t *testing.T) {
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		end    time.Time
+		window time.Duration
+		want   [][2]time.Time
+	}{
+		// TODO: Add test cases.
+		{"Exact", start.Add(14 * day), 7 * day, [][2]time.Time{
+			{start, start.Add(7 * day)},
+			{start.Add(7 * day), start.Add(14 * day)},
+		}},
+		{"Partial", start.Add(10 * day), 7 * day, [][2]time.Time{
+			{start, start.Add(7 * day)},
+			{start.Add(7 * day), start.Add(10 * day)},
+		}},
+		{"Empty", start, 7 * day, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitRange(start, tt.end, tt.window); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_mergeResults(t *testing.T) {
+	nan := math.NaN()
+	result := func(symbol string, ts []int64, close []float64, adj bool) *Result {
+		r := &Result{Meta: Meta{Symbol: symbol}, Timestamp: ts}
+		r.Indicators.Quote = []Quote{{
+			Volume: nullFloats(close...),
+			Close:  nullFloats(close...),
+			Open:   nullFloats(close...),
+			High:   nullFloats(close...),
+			Low:    nullFloats(close...),
+		}}
+		if adj {
+			r.Indicators.Adjclose = []Adjclose{{nullFloats(close...)}}
+		}
+		return r
+	}
+
+	tests := []struct {
+		name    string
+		results []*Result
+		want    *Result
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Overlap", []*Result{
+			result("A", []int64{60, 120}, []float64{1, 2}, true),
+			result("B", []int64{120, 180}, []float64{3, 4}, true),
+		}, result("B", []int64{60, 120, 180}, []float64{1, 3, 4}, true), false},
+		{"Unsorted", []*Result{
+			result("A", []int64{180}, []float64{3}, false),
+			{Meta: Meta{Symbol: "A"}},
+			result("A", []int64{60, 120}, []float64{1, 2}, false),
+		}, result("A", []int64{60, 120, 180}, []float64{1, 2, 3}, false), false},
+		{"MissingAdj", []*Result{
+			result("A", []int64{60}, []float64{1}, false),
+			result("A", []int64{120}, []float64{2}, true),
+		}, func() *Result {
+			r := result("A", []int64{60, 120}, []float64{1, 2}, true)
+			r.Indicators.Adjclose[0].Value = nullFloats(nan, 2)
+			return r
+		}(), false},
+		{"Empty", []*Result{{Meta: Meta{Symbol: "A"}}}, &Result{Meta: Meta{Symbol: "A"}}, false},
+		{"Mismatch", []*Result{{Timestamp: []int64{60}, Indicators: Indicators{Quote: []Quote{{}}}}}, nil, true},
+		{"None", nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeResults(tt.results...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("mergeResults() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeResults() = \n%+v, want \n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBetweenCall_Do_Split(t *testing.T) {
+	// every chunk replies a bar at period1 and period2, so chunks overlap at boundaries
+	var mu sync.Mutex
+	var got [][2]int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p1, _ := strconv.ParseInt(r.URL.Query().Get("period1"), 10, 64)
+		p2, _ := strconv.ParseInt(r.URL.Query().Get("period2"), 10, 64)
+		mu.Lock()
+		got = append(got, [2]int64{p1, p2})
+		mu.Unlock()
+		fmt.Fprintf(w, `{"chart":{"result":[{"meta":{"symbol":"VTI"},"timestamp":[%d,%d],"indicators":{"quote":[{"open":[1,2],"high":[1,2],"low":[1,2],"close":[1,2],"volume":[1,2]}]}}],"error":null}}`, p1, p2)
+	}))
+	defer ts.Close()
+
+	yfinanceTest, _ := New(GetClient(), WithHost(ts.URL))
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-20 * day)
+
+	tests := []struct {
+		name        string
+		interval    Interval
+		start       time.Time
+		concurrency int
+		wantCalls   int
+		wantBars    int
+		wantErr     bool
+	}{
+		// TODO: Add test cases.
+		{"1m", "1m", start, 1, 3, 4, false},
+		{"1mConcurrent", "1m", start, 3, 3, 4, false},
+		{"5m", "5m", start, 1, 1, 2, false},
+		{"1mTooOld", "1m", end.Add(-40 * day), 1, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			info, err := yfinanceTest.History.Between("VTI", tt.start, end).Interval(tt.interval).Concurrency(tt.concurrency).Do()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BetweenCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantCalls {
+				t.Errorf("BetweenCall.Do() requests = %v, want %d", got, tt.wantCalls)
+			}
+			if err != nil {
+				return
+			}
+			for _, r := range got {
+				if time.Duration(r[1]-r[0])*time.Second > intradayLimits[tt.interval].window {
+					t.Errorf("BetweenCall.Do() request %v exceeds %v", r, intradayLimits[tt.interval].window)
+				}
+			}
+			bars, err := info.Bars()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(bars) != tt.wantBars {
+				t.Errorf("BetweenCall.Do() bars = %v, want %d", bars, tt.wantBars)
+			}
+			for i := 1; i < len(bars); i++ {
+				if !bars[i].Time.After(bars[i-1].Time) {
+					t.Errorf("BetweenCall.Do() bars not sorted %v", bars)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package yahoofinance
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// IncomeStatement get income statements, Default is annual
+// https://query1.finance.yahoo.com/v10/finance/quoteSummary/AAPL?modules=incomeStatementHistory
+func (r *SummaryService) IncomeStatement(symbol string) *StatementCall {
+	return r.statement(symbol, IncomeStatementHistory, IncomeStatementHistoryQuarterly)
+}
+
+// BalanceSheet get balance sheets, Default is annual
+// https://query1.finance.yahoo.com/v10/finance/quoteSummary/AAPL?modules=balanceSheetHistory
+func (r *SummaryService) BalanceSheet(symbol string) *StatementCall {
+	return r.statement(symbol, BalanceSheetHistory, BalanceSheetHistoryQuarterly)
+}
+
+// CashFlow get cash flow statements, Default is annual
+// https://query1.finance.yahoo.com/v10/finance/quoteSummary/AAPL?modules=cashflowStatementHistory
+func (r *SummaryService) CashFlow(symbol string) *StatementCall {
+	return r.statement(symbol, CashflowStatementHistory, CashflowStatementHistoryQuarterly)
+}
+
+func (r *SummaryService) statement(symbol string, annual, quarterly Module) *StatementCall {
+	c := &StatementCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol:    symbol,
+		module:    annual,
+		quarterly: quarterly,
+	}
+
+	return c
+}
+
+// StatementCall call function
+type StatementCall struct {
+	DefaultCall
+
+	symbol    string
+	module    Module
+	quarterly Module
+}
+
+// Quarterly get quarterly statements instead of annual
+func (c *StatementCall) Quarterly() *StatementCall {
+	c.module = c.quarterly
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *StatementCall) Context(ctx context.Context) *StatementCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *StatementCall) SetHeader(key, value string) *StatementCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *StatementCall) Retry(policy *RetryPolicy) *StatementCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send request with ctx
+func (c *StatementCall) DoContext(ctx context.Context) (Statement, error) {
+	return c.Context(ctx).Do()
+}
+
+// Do send request
+func (c *StatementCall) Do() (Statement, error) {
+	dc := c.DefaultCall
+	dc.urlParams = cloneValues(c.urlParams)
+	call := &GetCall{
+		DefaultCall: dc,
+		symbol:      c.symbol,
+	}
+	info, err := call.Modules(c.module).Do()
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetCall.Do")
+	}
+	if len(info.QuoteSummary.Result) == 0 {
+		return nil, errors.Errorf("no result of %s", c.symbol)
+	}
+
+	m := info.QuoteSummary.Result[0].statement(c.module)
+	if m == nil {
+		return nil, errors.Errorf("no %s of %s", c.module, c.symbol)
+	}
+
+	return m.Statement, nil
+}
+
+// statement returns the statement module, nil if absent
+func (r *SummaryResult) statement(m Module) *StatementModule {
+	switch m {
+	case IncomeStatementHistory:
+		return r.IncomeStatementHistory
+	case IncomeStatementHistoryQuarterly:
+		return r.IncomeStatementHistoryQuarterly
+	case BalanceSheetHistory:
+		return r.BalanceSheetHistory
+	case BalanceSheetHistoryQuarterly:
+		return r.BalanceSheetHistoryQuarterly
+	case CashflowStatementHistory:
+		return r.CashflowStatementHistory
+	case CashflowStatementHistoryQuarterly:
+		return r.CashflowStatementHistoryQuarterly
+	}
+	return nil
+}
+
+// Timeseries get long histories of line items
+// https://query1.finance.yahoo.com/ws/fundamentals-timeseries/v1/finance/timeseries/AAPL?symbol=AAPL&type=annualTotalRevenue,quarterlyNetIncome&period1=493590046&period2=1607299200
+/*
+   :Parameters:
+       types : str
+           line items prefixed by annual, quarterly or trailing
+           e.g. annualTotalRevenue, quarterlyNetIncome, trailingEBITDA
+*/
+func (r *SummaryService) Timeseries(symbol string, types ...string) *TimeseriesCall {
+	c := &TimeseriesCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+
+	c.urlParams.Set("symbol", symbol)
+	c.urlParams.Set("type", strings.Join(types, ","))
+	c.urlParams.Set("period1", "493590046")
+	c.urlParams.Set("period2", strconv.FormatInt(time.Now().Unix(), 10))
+
+	return c
+}
+
+// TimeseriesCall call function
+type TimeseriesCall struct {
+	DefaultCall
+
+	symbol string
+}
+
+// Between Default is from 1985-08-22 to now
+func (c *TimeseriesCall) Between(start, end time.Time) *TimeseriesCall {
+	c.urlParams.Set("period1", strconv.FormatInt(start.Unix(), 10))
+	c.urlParams.Set("period2", strconv.FormatInt(end.Unix(), 10))
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *TimeseriesCall) Context(ctx context.Context) *TimeseriesCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *TimeseriesCall) SetHeader(key, value string) *TimeseriesCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *TimeseriesCall) Retry(policy *RetryPolicy) *TimeseriesCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send request with ctx
+func (c *TimeseriesCall) DoContext(ctx context.Context) (*TimeseriesInfomation, error) {
+	return c.Context(ctx).Do()
+}
+
+func (c *TimeseriesCall) doRequest() (*http.Response, error) {
+	return c.execute("/ws/fundamentals-timeseries/v1/finance/timeseries", c.symbol)
+}
+
+// Do send request
+func (c *TimeseriesCall) Do() (*TimeseriesInfomation, error) {
+	res, err := c.doRequest()
+	ret := &TimeseriesInfomation{}
+	if ret.ServerResponse, err = readResponse(ret, res, err); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,192 @@
+package yahoofinance
+
+import (
+	"math"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStatementCall_Do(t *testing.T) {
+	str := `{
+		"quoteSummary": {
+		  "result": [
+			{
+			  "incomeStatementHistoryQuarterly": {
+				"incomeStatementHistory": [
+				  {
+					"maxAge": 1,
+					"endDate": {"raw": 1601078400, "fmt": "2020-09-26"},
+					"totalRevenue": {"raw": 64698000000, "fmt": "64.7B", "longFmt": "64,698,000,000"},
+					"netIncome": {"raw": 12673000000, "fmt": "12.67B", "longFmt": "12,673,000,000"},
+					"discontinuedOperations": {}
+				  },
+				  {
+					"maxAge": 1,
+					"endDate": {"raw": 1593216000, "fmt": "2020-06-27"},
+					"totalRevenue": {"raw": 59685000000, "fmt": "59.69B", "longFmt": "59,685,000,000"},
+					"netIncome": {"raw": 11253000000, "fmt": "11.25B", "longFmt": "11,253,000,000"},
+					"discontinuedOperations": {}
+				  }
+				],
+				"maxAge": 86400
+			  }
+			}
+		  ],
+		  "error": null
+		}
+	  }`
+	client := clientTest(str, http.StatusOK)
+	yfinanceTest, _ := New(client)
+	invalid, _ := New(clientTest(strings.Replace(str, `"discontinuedOperations": {}`, `"discontinuedOperations": "-"`, 1), http.StatusOK))
+	nan := math.NaN()
+
+	tests := []struct {
+		name    string
+		c       *StatementCall
+		want    Statement
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Quarterly", NewSummaryService(yfinanceTest).IncomeStatement("AAPL").Quarterly(), Statement{
+			"2020-09-26": LineItems{
+				"totalRevenue":           nullFloats(64698000000)[0],
+				"netIncome":              nullFloats(12673000000)[0],
+				"discontinuedOperations": nullFloats(nan)[0],
+			},
+			"2020-06-27": LineItems{
+				"totalRevenue":           nullFloats(59685000000)[0],
+				"netIncome":              nullFloats(11253000000)[0],
+				"discontinuedOperations": nullFloats(nan)[0],
+			},
+		}, false},
+		{"Annual", NewSummaryService(yfinanceTest).IncomeStatement("AAPL"), nil, true},
+		{"InvalidLineItem", NewSummaryService(invalid).IncomeStatement("AAPL").Quarterly(), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.c.Do()
+			if len(tt.c.urlParams) != 0 {
+				t.Errorf("StatementCall.Do() modified urlParams = %v", tt.c.urlParams)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StatementCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StatementCall.Do() = \n%+v, \nwant \n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatement_Dates(t *testing.T) {
+	tests := []struct {
+		name string
+		s    Statement
+		want []string
+	}{
+		// TODO: Add test cases.
+		{"Test", Statement{"2020-09-26": nil, "2019-09-28": nil, "2020-06-27": nil}, []string{"2019-09-28", "2020-06-27", "2020-09-26"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Dates(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Statement.Dates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeseriesCall_doRequest(t *testing.T) {
+	client := clientTest("", http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *TimeseriesCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewSummaryService(yfinanceTest).Timeseries("AAPL", "annualTotalRevenue", "annualNetIncome").Between(time.Unix(493590046, 0), time.Unix(1607299200, 0)),
+			"https://query1.finance.yahoo.com/ws/fundamentals-timeseries/v1/finance/timeseries/AAPL?period1=493590046&period2=1607299200&symbol=AAPL&type=annualTotalRevenue%2CannualNetIncome", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.doRequest()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TimeseriesCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.Request.URL.String()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TimeseriesCall.doRequest() = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeseriesCall_Do(t *testing.T) {
+	str := `{
+		"timeseries": {
+		  "result": [
+			{
+			  "meta": {"symbol": ["AAPL"], "type": ["annualTotalRevenue"]},
+			  "timestamp": [1569628800, 1601078400],
+			  "annualTotalRevenue": [
+				{
+				  "dataId": 20100,
+				  "asOfDate": "2019-09-28",
+				  "periodType": "12M",
+				  "currencyCode": "USD",
+				  "reportedValue": {"raw": 260174000000, "fmt": "260.17B"}
+				},
+				null,
+				{
+				  "dataId": 20100,
+				  "asOfDate": "2020-09-26",
+				  "periodType": "12M",
+				  "currencyCode": "USD",
+				  "reportedValue": {"raw": 274515000000, "fmt": "274.52B"}
+				}
+			  ]
+			},
+			{
+			  "meta": {"symbol": ["AAPL"], "type": ["annualNetIncome"]}
+			}
+		  ],
+		  "error": null
+		}
+	  }`
+	client := clientTest(str, http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *TimeseriesCall
+		want    Statement
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewSummaryService(yfinanceTest).Timeseries("AAPL", "annualTotalRevenue", "annualNetIncome"), Statement{
+			"2019-09-28": LineItems{"annualTotalRevenue": nullFloats(260174000000)[0]},
+			"2020-09-26": LineItems{"annualTotalRevenue": nullFloats(274515000000)[0]},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TimeseriesCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.Statement()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TimeseriesCall.Do() = \n%+v, \nwant \n%+v", got, tt.want)
+			}
+		})
+	}
+}
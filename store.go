@@ -0,0 +1,102 @@
+package yahoofinance
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Store persists history, bars and events, per symbol and interval
+// Load and Save must be safe for concurrent use
+type Store interface {
+	// Load returns nil without error if nothing is stored
+	Load(symbol string, interval Interval) (*Result, error)
+	Save(symbol string, interval Interval, r *Result) error
+}
+
+// NewFileStore Store in dir, one JSON file per symbol and interval
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "os.MkdirAll")
+	}
+
+	return &FileStore{dir: dir, locks: make(map[string]*sync.Mutex)}, nil
+}
+
+// FileStore filesystem Store, dir/symbol/interval.json
+type FileStore struct {
+	dir string
+
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks path and returns its unlock
+func (s *FileStore) lock(path string) func() {
+	s.mu.Lock()
+	l, ok := s.locks[path]
+	if !ok {
+		l = &sync.Mutex{}
+		s.locks[path] = l
+	}
+	s.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// path symbols like ^GSPC or EURUSD=X are escaped
+func (s *FileStore) path(symbol string, interval Interval) string {
+	return filepath.Join(s.dir, url.PathEscape(symbol), url.PathEscape(string(interval))+".json")
+}
+
+// Load implements Store
+func (s *FileStore) Load(symbol string, interval Interval) (*Result, error) {
+	path := s.path(symbol, interval)
+	defer s.lock(path)()
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "ioutil.ReadFile")
+	}
+
+	r := &Result{}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, errors.Wrapf(err, "json.Unmarshal %s", path)
+	}
+	return r, nil
+}
+
+// Save implements Store
+// The file is written then renamed, so a crash never leaves a partial one
+func (s *FileStore) Save(symbol string, interval Interval, r *Result) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrapf(err, "json.Marshal")
+	}
+
+	path := s.path(symbol, interval)
+	defer s.lock(path)()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "os.MkdirAll")
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return errors.Wrapf(err, "ioutil.WriteFile")
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return errors.Wrapf(err, "os.Rename")
+	}
+
+	return nil
+}
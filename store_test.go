@@ -0,0 +1,58 @@
+package yahoofinance
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestFileStore(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	nan := math.NaN()
+
+	tests := []struct {
+		name     string
+		symbol   string
+		interval Interval
+		r        *Result
+	}{
+		// TODO: Add test cases.
+		{"Test", "VTI", Interval1d, &Result{
+			Meta:      Meta{Symbol: "VTI", Timezone: "EST", Gmtoffset: -18000},
+			Timestamp: []int64{60, 120},
+			Events:    Events{Dividends: map[string]Dividend{"60": {Amount: 0.5, Date: 60}}},
+			Indicators: Indicators{
+				Quote: []Quote{{
+					Volume: nullFloats(1, 2),
+					Close:  nullFloats(1, nan),
+					Open:   nullFloats(1, 2),
+					High:   nullFloats(1, 2),
+					Low:    nullFloats(1, 2),
+				}},
+				Adjclose: []Adjclose{{nullFloats(0.5, nan)}},
+			},
+		}},
+		{"Escape", "^GSPC", Interval1m, &Result{Meta: Meta{Symbol: "^GSPC"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.Load(tt.symbol, tt.interval)
+			if err != nil || got != nil {
+				t.Fatalf("FileStore.Load() before Save = %v, %v, want nil", got, err)
+			}
+			if err := store.Save(tt.symbol, tt.interval, tt.r); err != nil {
+				t.Fatalf("FileStore.Save() error = %v", err)
+			}
+			got, err = store.Load(tt.symbol, tt.interval)
+			if err != nil {
+				t.Fatalf("FileStore.Load() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.r) {
+				t.Errorf("FileStore.Load() = \n%+v, want \n%+v", got, tt.r)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package yahoofinance
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Module quoteSummary module
+type Module string
+
+// Valid modules
+const (
+	AssetProfile         Module = "assetProfile"
+	SummaryDetail        Module = "summaryDetail"
+	FinancialData        Module = "financialData"
+	DefaultKeyStatistics Module = "defaultKeyStatistics"
+	Price                Module = "price"
+
+	IncomeStatementHistory            Module = "incomeStatementHistory"
+	IncomeStatementHistoryQuarterly   Module = "incomeStatementHistoryQuarterly"
+	BalanceSheetHistory               Module = "balanceSheetHistory"
+	BalanceSheetHistoryQuarterly      Module = "balanceSheetHistoryQuarterly"
+	CashflowStatementHistory          Module = "cashflowStatementHistory"
+	CashflowStatementHistoryQuarterly Module = "cashflowStatementHistoryQuarterly"
+)
+
+// NewSummaryService get fundamentals
+func NewSummaryService(s *Service) *SummaryService {
+	rs := &SummaryService{s: s}
+	return rs
+}
+
+// SummaryService get fundamentals
+type SummaryService struct {
+	s *Service
+}
+
+// Get get modules of symbol
+// https://query1.finance.yahoo.com/v10/finance/quoteSummary/VTI?modules=assetProfile,financialData
+func (r *SummaryService) Get(symbol string) *GetCall {
+	c := &GetCall{
+		DefaultCall: DefaultCall{
+			s:         r.s,
+			urlParams: url.Values{},
+		},
+
+		symbol: symbol,
+	}
+
+	c.urlParams.Set("modules", string(Price))
+
+	return c
+}
+
+// GetCall call function
+type GetCall struct {
+	DefaultCall
+
+	symbol string
+}
+
+// Modules Default is price
+func (c *GetCall) Modules(modules ...Module) *GetCall {
+	ms := make([]string, len(modules))
+	for i, m := range modules {
+		ms[i] = string(m)
+	}
+	c.urlParams.Set("modules", strings.Join(ms, ","))
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *GetCall) Context(ctx context.Context) *GetCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *GetCall) SetHeader(key, value string) *GetCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *GetCall) Retry(policy *RetryPolicy) *GetCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send request with ctx
+func (c *GetCall) DoContext(ctx context.Context) (*SummaryInfomation, error) {
+	return c.Context(ctx).Do()
+}
+
+func (c *GetCall) doRequest() (*http.Response, error) {
+	return c.execute("/v10/finance/quoteSummary", c.symbol)
+}
+
+// Do send request
+func (c *GetCall) Do() (*SummaryInfomation, error) {
+	res, err := c.doRequest()
+	ret := &SummaryInfomation{}
+	if ret.ServerResponse, err = readResponse(ret, res, err); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,170 @@
+package yahoofinance
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetCall_doRequest(t *testing.T) {
+	client := clientTest("", http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *GetCall
+		want    string
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Default", NewSummaryService(yfinanceTest).Get("VTI"),
+			"https://query1.finance.yahoo.com/v10/finance/quoteSummary/VTI?modules=price", false},
+		{"Modules", NewSummaryService(yfinanceTest).Get("AAPL").Modules(AssetProfile, FinancialData, DefaultKeyStatistics),
+			"https://query1.finance.yahoo.com/v10/finance/quoteSummary/AAPL?modules=assetProfile%2CfinancialData%2CdefaultKeyStatistics", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.doRequest()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCall.doRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp.Request.URL.String()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetCall.doRequest() = \n%v, want \n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetCall_Do(t *testing.T) {
+	str := `{
+		"quoteSummary": {
+		  "result": [
+			{
+			  "assetProfile": {
+				"city": "Cupertino",
+				"country": "United States",
+				"industry": "Consumer Electronics",
+				"sector": "Technology",
+				"fullTimeEmployees": 147000,
+				"companyOfficers": [
+				  {
+					"maxAge": 1,
+					"name": "Mr. Timothy D. Cook",
+					"age": 59,
+					"title": "CEO & Director",
+					"yearBorn": 1961,
+					"fiscalYear": 2020,
+					"totalPay": {"raw": 14769259, "fmt": "14.77M", "longFmt": "14,769,259"}
+				  }
+				],
+				"maxAge": 86400
+			  },
+			  "financialData": {
+				"maxAge": 86400,
+				"currentPrice": {"raw": 123.75, "fmt": "123.75"},
+				"recommendationKey": "buy",
+				"totalCash": {"raw": 90943000576, "fmt": "90.94B", "longFmt": "90,943,000,576"},
+				"earningsGrowth": {},
+				"financialCurrency": "USD"
+			  },
+			  "defaultKeyStatistics": {
+				"maxAge": 1,
+				"52WeekChange": {"raw": 0.7913, "fmt": "79.13%"},
+				"lastSplitFactor": "4:1",
+				"lastSplitDate": {"raw": 1598832000, "fmt": "2020-08-31"}
+			  }
+			}
+		  ],
+		  "error": null
+		}
+	  }`
+	client := clientTest(str, http.StatusOK)
+	yfinanceTest, _ := New(client)
+
+	tests := []struct {
+		name    string
+		c       *GetCall
+		want    *SummaryInfomation
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", NewSummaryService(yfinanceTest).Get("AAPL").Modules(AssetProfile, FinancialData, DefaultKeyStatistics), &SummaryInfomation{
+			ServerResponse: ServerResponse{
+				HTTPStatusCode: 200,
+				Header:         map[string][]string{},
+			},
+			QuoteSummary: QuoteSummary{
+				Result: []SummaryResult{
+					{
+						AssetProfile: &AssetProfileModule{
+							City:              "Cupertino",
+							Country:           "United States",
+							Industry:          "Consumer Electronics",
+							Sector:            "Technology",
+							FullTimeEmployees: 147000,
+							CompanyOfficers: []CompanyOfficer{
+								{
+									Name:       "Mr. Timothy D. Cook",
+									Age:        59,
+									Title:      "CEO & Director",
+									YearBorn:   1961,
+									FiscalYear: 2020,
+									TotalPay:   Value{Raw: NullFloat64{Float64: 14769259, Valid: true}, Fmt: "14.77M", LongFmt: "14,769,259"},
+								},
+							},
+							MaxAge: 86400,
+						},
+						FinancialData: &FinancialDataModule{
+							CurrentPrice:      Value{Raw: NullFloat64{Float64: 123.75, Valid: true}, Fmt: "123.75"},
+							RecommendationKey: "buy",
+							TotalCash:         Value{Raw: NullFloat64{Float64: 90943000576, Valid: true}, Fmt: "90.94B", LongFmt: "90,943,000,576"},
+							FinancialCurrency: "USD",
+							MaxAge:            86400,
+						},
+						DefaultKeyStatistics: &DefaultKeyStatisticsModule{
+							FiftyTwoWeekChange: Value{Raw: NullFloat64{Float64: 0.7913, Valid: true}, Fmt: "79.13%"},
+							LastSplitFactor:    "4:1",
+							LastSplitDate:      Value{Raw: NullFloat64{Float64: 1598832000, Valid: true}, Fmt: "2020-08-31"},
+							MaxAge:             1,
+						},
+					},
+				},
+				Error: ErrorHistory{},
+			},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := tt.c.Do()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCall.Do() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := rsp
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetCall.Do() = \n%+v, \nwant \n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_Time(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Value
+		want time.Time
+	}{
+		// TODO: Add test cases.
+		{"Test", Value{Raw: NullFloat64{Float64: 1598832000, Valid: true}, Fmt: "2020-08-31"}, time.Date(2020, time.August, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Time(); !got.Equal(tt.want) {
+				t.Errorf("Value.Time() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package yahoofinance
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// earliest start of a full daily history, 1900-01-01
+var earliest = time.Unix(-2208988800, 0)
+
+// revisionTolerance relative difference of prices and volumes treated as a revision
+const revisionTolerance = 1e-9
+
+// SetStore persists history synced by HistoryService.Sync
+func (s *Service) SetStore(store Store) {
+	s.store = store
+}
+
+// the default store shared by services without one, so its locks are too
+var (
+	defaultStoreOnce sync.Once
+	defaultFileStore *FileStore
+	defaultStoreErr  error
+)
+
+// defaultStore FileStore in the user cache directory
+func defaultStore() (Store, error) {
+	defaultStoreOnce.Do(func() {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			defaultStoreErr = errors.Wrapf(err, "os.UserCacheDir")
+			return
+		}
+		defaultFileStore, defaultStoreErr = NewFileStore(filepath.Join(dir, "yahoofinance"))
+	})
+	if defaultStoreErr != nil {
+		return nil, defaultStoreErr
+	}
+	return defaultFileStore, nil
+}
+
+// Sync updates the stored history of symbol at interval
+// Only the bars after the last stored one are fetched, along with a few
+// stored bars to detect revisions, e.g. prices adjusted for a new split.
+// A revision refetches the whole history.
+// Intraday history stored before Yahoo's lookback is continued from the
+// earliest bar Yahoo serves, see SyncResult.Gap.
+// The store of Service is used, a FileStore in the user cache directory by default.
+// Syncs of different symbols or intervals can run concurrently.
+func (r *HistoryService) Sync(symbol string, interval Interval) *SyncCall {
+	c := &SyncCall{
+		DefaultCall: DefaultCall{
+			s: r.s,
+		},
+
+		symbol:   symbol,
+		interval: interval,
+		overlap:  3,
+	}
+
+	return c
+}
+
+// SyncCall call function
+type SyncCall struct {
+	DefaultCall
+
+	symbol   string
+	interval Interval
+	overlap  int
+	since    time.Time
+}
+
+// SyncResult stored history after Sync
+type SyncResult struct {
+	Result *Result
+	// Added is the number of bars not stored before.
+	Added int
+	// Revised reports whether stored bars changed and the history was refetched.
+	Revised bool
+	// Gap reports whether the stored bars ended before the earliest one Yahoo
+	// serves for an intraday interval, so the bars in between are missing.
+	Gap bool
+}
+
+// Overlap number of stored bars fetched again to detect revisions, Default is 3
+func (c *SyncCall) Overlap(n int) *SyncCall {
+	if n < 0 {
+		n = 0
+	}
+	c.overlap = n
+	return c
+}
+
+// Since start of the history when nothing is stored,
+// Default is 1900-01-01 or the earliest one allowed for intraday intervals
+func (c *SyncCall) Since(t time.Time) *SyncCall {
+	c.since = t
+	return c
+}
+
+// Context sets the context to be used in this call's Do method. Any
+// pending HTTP request will be aborted if the provided context is
+// canceled.
+func (c *SyncCall) Context(ctx context.Context) *SyncCall {
+	c.ctx = ctx
+	return c
+}
+
+// SetHeader sets the HTTP header key to value
+func (c *SyncCall) SetHeader(key, value string) *SyncCall {
+	c.Header().Set(key, value)
+	return c
+}
+
+// Retry overrides the retry policy of Service for this call,
+// &RetryPolicy{} disables retry
+func (c *SyncCall) Retry(policy *RetryPolicy) *SyncCall {
+	c.retry = policy
+	return c
+}
+
+// DoContext send requests with ctx
+func (c *SyncCall) DoContext(ctx context.Context) (*SyncResult, error) {
+	return c.Context(ctx).Do()
+}
+
+// start of the full history at now
+func (c *SyncCall) start(now time.Time) time.Time {
+	if !c.since.IsZero() {
+		return c.since
+	}
+	if limit, ok := intradayLimits[c.interval]; ok {
+		// a margin keeps the start within the limit when the request is sent
+		return now.Add(-limit.lookback + time.Hour)
+	}
+	return earliest
+}
+
+// fetch history between start and end
+func (c *SyncCall) fetch(start, end time.Time) (*Result, error) {
+	call := c.s.History.Between(c.symbol, start, end).Interval(c.interval)
+	call.ctx = c.ctx
+	call.header = c.header
+	call.retry = c.retry
+
+	info, err := call.Do()
+	if err != nil {
+		return nil, err
+	}
+	if len(info.Chart.Result) == 0 {
+		return &Result{}, nil
+	}
+	return &info.Chart.Result[0], nil
+}
+
+// Do send requests and save the merged history
+func (c *SyncCall) Do() (*SyncResult, error) {
+	store := c.s.store
+	if store == nil {
+		var err error
+		if store, err = defaultStore(); err != nil {
+			return nil, errors.Wrapf(err, "defaultStore")
+		}
+	}
+
+	stored, err := store.Load(c.symbol, c.interval)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Load")
+	}
+	now := time.Now()
+
+	ret := &SyncResult{}
+	if stored == nil || len(stored.Timestamp) == 0 {
+		if ret.Result, err = c.fetch(c.start(now), now); err != nil {
+			return nil, errors.Wrapf(err, "fetch")
+		}
+		ret.Added = len(ret.Result.Timestamp)
+	} else {
+		i := len(stored.Timestamp) - 1 - c.overlap
+		if i < 0 {
+			i = 0
+		}
+		// the last stored bar may have been incomplete, so it is not compared
+		from, compared := time.Unix(stored.Timestamp[i], 0), stored.Timestamp[i:len(stored.Timestamp)-1]
+		if limit, ok := intradayLimits[c.interval]; ok {
+			// Yahoo rejects requests starting before its lookback
+			if earliest := now.Add(-limit.lookback + time.Hour); from.Before(earliest) {
+				from = earliest
+				ret.Gap = stored.Timestamp[len(stored.Timestamp)-1] < earliest.Unix()
+				for len(compared) > 0 && compared[0] < earliest.Unix() {
+					compared = compared[1:]
+				}
+			}
+		}
+		fetched, err := c.fetch(from, now)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fetch")
+		}
+
+		changed, err := revised(stored, fetched, compared)
+		if err != nil {
+			return nil, errors.Wrapf(err, "revised")
+		}
+		if changed {
+			ret.Revised = true
+			if ret.Result, err = c.fetch(c.start(now), now); err != nil {
+				return nil, errors.Wrapf(err, "fetch")
+			}
+		} else if ret.Result, err = mergeResults(stored, fetched); err != nil {
+			return nil, errors.Wrapf(err, "mergeResults")
+		}
+		ret.Added = len(ret.Result.Timestamp) - len(stored.Timestamp)
+		if ret.Added < 0 {
+			ret.Added = 0
+		}
+	}
+
+	if err := store.Save(c.symbol, c.interval, ret.Result); err != nil {
+		return nil, errors.Wrapf(err, "Save")
+	}
+
+	return ret, nil
+}
+
+// sameValue reports whether a and b are both null or equal within revisionTolerance
+func sameValue(a, b NullFloat64) bool {
+	if !a.Valid || !b.Valid {
+		return a.Valid == b.Valid
+	}
+	return math.Abs(a.Float64-b.Float64) <= revisionTolerance*math.Max(math.Abs(a.Float64), math.Abs(b.Float64))
+}
+
+// revised reports whether any bar of stored at timestamps is missing or different in fetched,
+// values are compared within revisionTolerance since encoding may round them
+func revised(stored, fetched *Result, timestamps []int64) (bool, error) {
+	sq, sadj, err := stored.series()
+	if err != nil {
+		return false, errors.Wrapf(err, "stored")
+	}
+	fq, fadj, err := fetched.series()
+	if err != nil {
+		return false, errors.Wrapf(err, "fetched")
+	}
+	if len(timestamps) == 0 {
+		return false, nil
+	}
+	if sq == nil || fq == nil {
+		return true, nil
+	}
+
+	index := func(r *Result) map[int64]int {
+		m := make(map[int64]int, len(r.Timestamp))
+		for i, ts := range r.Timestamp {
+			m[ts] = i
+		}
+		return m
+	}
+	si, fi := index(stored), index(fetched)
+	for _, ts := range timestamps {
+		i := si[ts]
+		j, ok := fi[ts]
+		if !ok {
+			return true, nil
+		}
+		if !sameValue(sq.Open[i], fq.Open[j]) || !sameValue(sq.High[i], fq.High[j]) || !sameValue(sq.Low[i], fq.Low[j]) ||
+			!sameValue(sq.Close[i], fq.Close[j]) || !sameValue(sq.Volume[i], fq.Volume[j]) {
+			return true, nil
+		}
+		if sadj != nil && fadj != nil && !sameValue(sadj[i], fadj[j]) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,219 @@
+package yahoofinance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// historyServer serves daily closes of each symbol between period1 and period2
+type historyServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	closes   map[string][]float64
+	start    int64
+	period1s map[string][]int64
+}
+
+func newHistoryServer(start int64) *historyServer {
+	h := &historyServer{closes: map[string][]float64{}, start: start, period1s: map[string][]int64{}}
+	h.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		symbol := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		p1, _ := strconv.ParseInt(r.URL.Query().Get("period1"), 10, 64)
+		p2, _ := strconv.ParseInt(r.URL.Query().Get("period2"), 10, 64)
+
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.period1s[symbol] = append(h.period1s[symbol], p1)
+		var ts []int64
+		var closes []float64
+		for i, c := range h.closes[symbol] {
+			t := h.start + int64(i)*86400
+			if t >= p1 && t <= p2 {
+				ts = append(ts, t)
+				closes = append(closes, c)
+			}
+		}
+		tsJSON, _ := json.Marshal(ts)
+		closesJSON, _ := json.Marshal(closes)
+		fmt.Fprintf(w, `{"chart":{"result":[{"meta":{"symbol":"%s"},"timestamp":%s,"indicators":{"quote":[{"open":%s,"high":%s,"low":%s,"close":%s,"volume":%s}]}}],"error":null}}`,
+			symbol, tsJSON, closesJSON, closesJSON, closesJSON, closesJSON, closesJSON)
+	}))
+	return h
+}
+
+func (h *historyServer) set(symbol string, closes ...float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closes[symbol] = closes
+}
+
+func TestSyncCall_Do(t *testing.T) {
+	start := time.Now().Add(-30 * day).Truncate(day)
+	ts := newHistoryServer(start.Unix())
+	defer ts.Close()
+
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	yfinanceTest, _ := New(GetClient(), WithHost(ts.URL), WithStore(store))
+
+	tests := []struct {
+		name        string
+		closes      []float64
+		wantAdded   int
+		wantRevised bool
+		wantPeriod1 int64
+	}{
+		// TODO: Add test cases.
+		{"Initial", []float64{1, 2, 3, 4, 5, 6}, 6, false, start.Unix()},
+		{"Incremental", []float64{1, 2, 3, 4, 5, 6.5, 7, 8}, 2, false, start.Unix() + 2*86400},
+		{"UpToDate", []float64{1, 2, 3, 4, 5, 6.5, 7, 8}, 0, false, start.Unix() + 4*86400},
+		{"Rounding", []float64{1, 2, 3, 4, 5, 6.5, 7 * (1 + 1e-12), 8}, 0, false, start.Unix() + 4*86400},
+		{"Revision", []float64{0.5, 1, 1.5, 2, 2.5, 3.25, 3.5, 4, 4.5}, 1, true, start.Unix()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts.set("VTI", tt.closes...)
+			got, err := yfinanceTest.History.Sync("VTI", Interval1d).Since(start).Do()
+			if err != nil {
+				t.Fatalf("SyncCall.Do() error = %v", err)
+			}
+			if got.Added != tt.wantAdded || got.Revised != tt.wantRevised {
+				t.Errorf("SyncCall.Do() Added = %v, Revised = %v, want %v, %v", got.Added, got.Revised, tt.wantAdded, tt.wantRevised)
+			}
+			period1s := ts.period1s["VTI"]
+			if period1s[len(period1s)-1] != tt.wantPeriod1 {
+				t.Errorf("SyncCall.Do() last period1 = %v, want %v", period1s[len(period1s)-1], tt.wantPeriod1)
+			}
+
+			stored, err := store.Load("VTI", Interval1d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bars, err := stored.Bars()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(bars) != len(tt.closes) {
+				t.Fatalf("stored bars = %v, want %v", bars, tt.closes)
+			}
+			for i, b := range bars {
+				if b.Close != tt.closes[i] {
+					t.Errorf("stored bar %d close = %v, want %v", i, b.Close, tt.closes[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSyncCall_Do_DefaultStore(t *testing.T) {
+	cache := t.TempDir()
+	defer os.Setenv("XDG_CACHE_HOME", os.Getenv("XDG_CACHE_HOME"))
+	os.Setenv("XDG_CACHE_HOME", cache)
+	if dir, err := os.UserCacheDir(); err != nil || dir != cache {
+		t.Skipf("os.UserCacheDir() = %v, %v, not set by XDG_CACHE_HOME", dir, err)
+	}
+
+	start := time.Now().Add(-30 * day).Truncate(day)
+	ts := newHistoryServer(start.Unix())
+	defer ts.Close()
+	ts.set("VTI", 1, 2, 3)
+
+	yfinanceTest, _ := New(GetClient(), WithHost(ts.URL))
+	if _, err := yfinanceTest.History.Sync("VTI", Interval1d).Since(start).Do(); err != nil {
+		t.Fatalf("SyncCall.Do() error = %v", err)
+	}
+	store, err := NewFileStore(filepath.Join(cache, "yahoofinance"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := store.Load("VTI", Interval1d)
+	if err != nil || stored == nil || len(stored.Timestamp) != 3 {
+		t.Errorf("stored = %+v, %v, want 3 bars in the user cache directory", stored, err)
+	}
+}
+
+func TestSyncCall_Do_Stale(t *testing.T) {
+	now := time.Now()
+	ts := newHistoryServer(now.Add(-2 * day).Unix())
+	defer ts.Close()
+	ts.set("VTI", 4, 5)
+
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := now.Add(-40 * day).Unix()
+	if err := store.Save("VTI", Interval1m, testResult(Meta{Symbol: "VTI"}, []int64{old, old + 60, old + 120}, 0, 1, 2, 3)); err != nil {
+		t.Fatal(err)
+	}
+	yfinanceTest, _ := New(GetClient(), WithHost(ts.URL), WithStore(store))
+
+	got, err := yfinanceTest.History.Sync("VTI", Interval1m).Do()
+	if err != nil {
+		t.Fatalf("SyncCall.Do() error = %v", err)
+	}
+	if !got.Gap || got.Revised || got.Added != 2 {
+		t.Errorf("SyncCall.Do() Added = %v, Revised = %v, Gap = %v, want 2, false, true", got.Added, got.Revised, got.Gap)
+	}
+	for _, p1 := range ts.period1s["VTI"] {
+		if p1 < now.Add(-30*day).Unix() {
+			t.Errorf("SyncCall.Do() period1 = %v, before the lookback of 1m", p1)
+		}
+	}
+	if stored, err := store.Load("VTI", Interval1m); err != nil || len(stored.Timestamp) != 5 {
+		t.Errorf("stored = %+v, %v, want 5 bars", stored, err)
+	}
+}
+
+func TestSyncCall_Do_Concurrent(t *testing.T) {
+	start := time.Now().Add(-30 * day).Truncate(day)
+	ts := newHistoryServer(start.Unix())
+	defer ts.Close()
+
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	yfinanceTest, _ := New(GetClient(), WithHost(ts.URL), WithStore(store))
+
+	symbols := []string{"A", "B", "C", "D"}
+	for i, symbol := range symbols {
+		ts.set(symbol, float64(i), float64(i+1), float64(i+2))
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(symbols))
+	for i, symbol := range symbols {
+		wg.Add(1)
+		go func(i int, symbol string) {
+			defer wg.Done()
+			_, errs[i] = yfinanceTest.History.Sync(symbol, Interval1d).Since(start).Do()
+		}(i, symbol)
+	}
+	wg.Wait()
+
+	for i, symbol := range symbols {
+		if errs[i] != nil {
+			t.Fatalf("SyncCall.Do() %s error = %v", symbol, errs[i])
+		}
+		stored, err := store.Load(symbol, Interval1d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(stored.Timestamp) != 3 || stored.Meta.Symbol != symbol {
+			t.Errorf("stored %s = %+v, want 3 bars", symbol, stored)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package yahoofinance
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// types of the Thrift compact protocol used by Parquet metadata
+const (
+	ctStop   = 0
+	ctTrue   = 1
+	ctFalse  = 2
+	ctByte   = 3
+	ctI16    = 4
+	ctI32    = 5
+	ctI64    = 6
+	ctDouble = 7
+	ctBinary = 8
+	ctList   = 9
+	ctSet    = 10
+	ctMap    = 11
+	ctStruct = 12
+)
+
+// compactWriter encodes Thrift structs in the compact protocol
+type compactWriter struct {
+	b    []byte
+	last []int16 // last field id of each open struct
+}
+
+func (w *compactWriter) varint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.b = append(w.b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func (w *compactWriter) zigzag(v int64) {
+	w.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *compactWriter) field(id int16, typ byte) {
+	last := &w.last[len(w.last)-1]
+	if d := id - *last; d > 0 && d <= 15 {
+		w.b = append(w.b, byte(d)<<4|typ)
+	} else {
+		w.b = append(w.b, typ)
+		w.zigzag(int64(id))
+	}
+	*last = id
+}
+
+// begin starts a struct, a field of it or an element of a list
+func (w *compactWriter) begin() {
+	w.last = append(w.last, 0)
+}
+
+// end closes the struct started by begin
+func (w *compactWriter) end() {
+	w.b = append(w.b, ctStop)
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *compactWriter) i32(id int16, v int32) {
+	w.field(id, ctI32)
+	w.zigzag(int64(v))
+}
+
+func (w *compactWriter) i64(id int16, v int64) {
+	w.field(id, ctI64)
+	w.zigzag(v)
+}
+
+func (w *compactWriter) bool(id int16, v bool) {
+	if v {
+		w.field(id, ctTrue)
+	} else {
+		w.field(id, ctFalse)
+	}
+}
+
+func (w *compactWriter) binary(id int16, s string) {
+	w.field(id, ctBinary)
+	w.elemBinary(s)
+}
+
+// structField starts a struct field, close it by end
+func (w *compactWriter) structField(id int16) {
+	w.field(id, ctStruct)
+	w.begin()
+}
+
+// list starts a list field of n elements written by elem* or begin and end
+func (w *compactWriter) list(id int16, elem byte, n int) {
+	w.field(id, ctList)
+	if n < 15 {
+		w.b = append(w.b, byte(n)<<4|elem)
+	} else {
+		w.b = append(w.b, 0xf0|elem)
+		w.varint(uint64(n))
+	}
+}
+
+func (w *compactWriter) elemI32(v int32) {
+	w.zigzag(int64(v))
+}
+
+func (w *compactWriter) elemBinary(s string) {
+	w.varint(uint64(len(s)))
+	w.b = append(w.b, s...)
+}
+
+// thriftStruct decoded struct by field id, values are
+// bool, int64, float64, []byte, []interface{} or thriftStruct
+type thriftStruct map[int16]interface{}
+
+func (s thriftStruct) int(id int16) int64 {
+	v, _ := s[id].(int64)
+	return v
+}
+
+func (s thriftStruct) str(id int16) string {
+	v, _ := s[id].([]byte)
+	return string(v)
+}
+
+func (s thriftStruct) list(id int16) []interface{} {
+	v, _ := s[id].([]interface{})
+	return v
+}
+
+func (s thriftStruct) strct(id int16) thriftStruct {
+	v, _ := s[id].(thriftStruct)
+	return v
+}
+
+// compactReader decodes Thrift structs in the compact protocol
+type compactReader struct {
+	b     []byte
+	pos   int
+	depth int
+}
+
+// thriftMaxDepth of nested structs and lists, deeper input is rejected
+const thriftMaxDepth = 32
+
+var errThriftShort = errors.New("thrift: unexpected end of data")
+
+func (r *compactReader) byte() (byte, error) {
+	if r.pos >= len(r.b) {
+		return 0, errThriftShort
+	}
+	r.pos++
+	return r.b[r.pos-1], nil
+}
+
+func (r *compactReader) varint() (uint64, error) {
+	v, n := binary.Uvarint(r.b[r.pos:])
+	if n <= 0 {
+		return 0, errThriftShort
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *compactReader) zigzag() (int64, error) {
+	v, err := r.varint()
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (r *compactReader) bytes(n int) ([]byte, error) {
+	if n < 0 || n > len(r.b)-r.pos {
+		return nil, errThriftShort
+	}
+	r.pos += n
+	return r.b[r.pos-n : r.pos], nil
+}
+
+// readStruct reads fields until stop
+func (r *compactReader) readStruct() (thriftStruct, error) {
+	if r.depth++; r.depth > thriftMaxDepth {
+		return nil, errors.New("thrift: nesting too deep")
+	}
+	defer func() { r.depth-- }()
+
+	s := thriftStruct{}
+	var last int16
+	for {
+		h, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		typ := h & 0x0f
+		if typ == ctStop {
+			return s, nil
+		}
+
+		id := last + int16(h>>4)
+		if h>>4 == 0 {
+			v, err := r.zigzag()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		last = id
+
+		switch typ {
+		case ctTrue, ctFalse:
+			s[id] = typ == ctTrue
+		default:
+			if s[id], err = r.readValue(typ); err != nil {
+				return nil, errors.Wrapf(err, "field %d", id)
+			}
+		}
+	}
+}
+
+// readValue reads a value of typ outside a field header
+func (r *compactReader) readValue(typ byte) (interface{}, error) {
+	switch typ {
+	case ctTrue, ctFalse:
+		b, err := r.byte()
+		return b == ctTrue, err
+	case ctByte:
+		b, err := r.byte()
+		return int64(int8(b)), err
+	case ctI16, ctI32, ctI64:
+		return r.zigzag()
+	case ctDouble:
+		b, err := r.bytes(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case ctBinary:
+		n, err := r.varint()
+		if err != nil {
+			return nil, err
+		}
+		return r.bytes(int(n))
+	case ctList, ctSet:
+		h, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		n := uint64(h >> 4)
+		if n == 15 {
+			if n, err = r.varint(); err != nil {
+				return nil, err
+			}
+		}
+		// every element takes a byte at least
+		if n > uint64(len(r.b)-r.pos) {
+			return nil, errThriftShort
+		}
+		if r.depth++; r.depth > thriftMaxDepth {
+			return nil, errors.New("thrift: nesting too deep")
+		}
+		defer func() { r.depth-- }()
+
+		var l []interface{}
+		for i := uint64(0); i < n; i++ {
+			v, err := r.readValue(h & 0x0f)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		return l, nil
+	case ctMap:
+		n, err := r.varint()
+		if err != nil || n == 0 {
+			return nil, err
+		}
+		kv, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < n; i++ {
+			if _, err := r.readValue(kv >> 4); err != nil {
+				return nil, err
+			}
+			if _, err := r.readValue(kv & 0x0f); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	case ctStruct:
+		return r.readStruct()
+	}
+
+	return nil, errors.Errorf("thrift: unknown type %d", typ)
+}
@@ -1,9 +1,16 @@
 package yahoofinance
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 // ServerResponse is embedded in each Do response and
@@ -22,6 +29,7 @@ type DefaultCall struct {
 	urlParams url.Values
 	ctx       context.Context
 	header    http.Header
+	retry     *RetryPolicy
 }
 
 // Context sets the context to be used in this call's Do method. Any
@@ -43,6 +51,37 @@ func (c *DefaultCall) Header() http.Header {
 
 // ===============================================================================================================
 
+// NullFloat64 float64 which may be null, e.g. missing bars
+type NullFloat64 struct {
+	Float64 float64
+	Valid   bool // Valid is true if Float64 is not null
+}
+
+// UnmarshalJSON decodes null as invalid
+func (n *NullFloat64) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = NullFloat64{}
+		return nil
+	}
+	if err := json.Unmarshal(b, &n.Float64); err != nil {
+		return err
+	}
+	n.Valid = true
+
+	return nil
+}
+
+// MarshalJSON encodes invalid as null
+func (n NullFloat64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return []byte(strconv.FormatFloat(n.Float64, 'g', -1, 64)), nil
+}
+
+// ===============================================================================================================
+
 // TimeInfo TimeInfo
 type TimeInfo struct {
 	Timezone  string `json:"timezone"`
@@ -100,16 +139,16 @@ type Events struct {
 
 // Quote Quote
 type Quote struct {
-	Volume []float64 `json:"volume"`
-	Close  []float64 `json:"close"`
-	Open   []float64 `json:"open"`
-	High   []float64 `json:"high"`
-	Low    []float64 `json:"low"`
+	Volume []NullFloat64 `json:"volume"`
+	Close  []NullFloat64 `json:"close"`
+	Open   []NullFloat64 `json:"open"`
+	High   []NullFloat64 `json:"high"`
+	Low    []NullFloat64 `json:"low"`
 }
 
 // Adjclose Adjclose
 type Adjclose struct {
-	Value []float64 `json:"adjclose"`
+	Value []NullFloat64 `json:"adjclose"`
 }
 
 // Indicators Indicators
@@ -145,3 +184,552 @@ type Infomation struct {
 	ServerResponse `json:"-"`
 	Chart          Chart `json:"chart"`
 }
+
+// ActionsInfomation dividends and splits sorted by time
+type ActionsInfomation struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+	Symbol         string
+	Dividends      []DividendEvent
+	Splits         []SplitEvent
+}
+
+// ===============================================================================================================
+
+// QuoteResult quote of a symbol
+type QuoteResult struct {
+	Symbol                    string `json:"symbol"`
+	ShortName                 string `json:"shortName"`
+	LongName                  string `json:"longName"`
+	QuoteType                 string `json:"quoteType"`
+	Currency                  string `json:"currency"`
+	Exchange                  string `json:"exchange"`
+	FullExchangeName          string `json:"fullExchangeName"`
+	ExchangeTimezoneName      string `json:"exchangeTimezoneName"`
+	ExchangeTimezoneShortName string `json:"exchangeTimezoneShortName"`
+	GmtOffSetMilliseconds     int64  `json:"gmtOffSetMilliseconds"`
+	Market                    string `json:"market"`
+	// MarketState PREPRE, PRE, REGULAR, POST, POSTPOST or CLOSED
+	MarketState string `json:"marketState"`
+	Tradeable   bool   `json:"tradeable"`
+	PriceHint   int    `json:"priceHint"`
+
+	RegularMarketPrice         float64 `json:"regularMarketPrice"`
+	RegularMarketChange        float64 `json:"regularMarketChange"`
+	RegularMarketChangePercent float64 `json:"regularMarketChangePercent"`
+	RegularMarketTime          int64   `json:"regularMarketTime"`
+	RegularMarketOpen          float64 `json:"regularMarketOpen"`
+	RegularMarketDayHigh       float64 `json:"regularMarketDayHigh"`
+	RegularMarketDayLow        float64 `json:"regularMarketDayLow"`
+	RegularMarketDayRange      string  `json:"regularMarketDayRange"`
+	RegularMarketVolume        int64   `json:"regularMarketVolume"`
+	RegularMarketPreviousClose float64 `json:"regularMarketPreviousClose"`
+
+	Bid     float64 `json:"bid"`
+	Ask     float64 `json:"ask"`
+	BidSize int64   `json:"bidSize"`
+	AskSize int64   `json:"askSize"`
+
+	FiftyTwoWeekLow               float64 `json:"fiftyTwoWeekLow"`
+	FiftyTwoWeekHigh              float64 `json:"fiftyTwoWeekHigh"`
+	FiftyTwoWeekRange             string  `json:"fiftyTwoWeekRange"`
+	FiftyTwoWeekLowChange         float64 `json:"fiftyTwoWeekLowChange"`
+	FiftyTwoWeekLowChangePercent  float64 `json:"fiftyTwoWeekLowChangePercent"`
+	FiftyTwoWeekHighChange        float64 `json:"fiftyTwoWeekHighChange"`
+	FiftyTwoWeekHighChangePercent float64 `json:"fiftyTwoWeekHighChangePercent"`
+
+	FiftyDayAverage                   float64 `json:"fiftyDayAverage"`
+	FiftyDayAverageChange             float64 `json:"fiftyDayAverageChange"`
+	FiftyDayAverageChangePercent      float64 `json:"fiftyDayAverageChangePercent"`
+	TwoHundredDayAverage              float64 `json:"twoHundredDayAverage"`
+	TwoHundredDayAverageChange        float64 `json:"twoHundredDayAverageChange"`
+	TwoHundredDayAverageChangePercent float64 `json:"twoHundredDayAverageChangePercent"`
+	AverageDailyVolume3Month          int64   `json:"averageDailyVolume3Month"`
+	AverageDailyVolume10Day           int64   `json:"averageDailyVolume10Day"`
+
+	MarketCap                   int64   `json:"marketCap"`
+	SharesOutstanding           int64   `json:"sharesOutstanding"`
+	TrailingPE                  float64 `json:"trailingPE"`
+	ForwardPE                   float64 `json:"forwardPE"`
+	EpsTrailingTwelveMonths     float64 `json:"epsTrailingTwelveMonths"`
+	EpsForward                  float64 `json:"epsForward"`
+	BookValue                   float64 `json:"bookValue"`
+	PriceToBook                 float64 `json:"priceToBook"`
+	TrailingAnnualDividendRate  float64 `json:"trailingAnnualDividendRate"`
+	TrailingAnnualDividendYield float64 `json:"trailingAnnualDividendYield"`
+	DividendDate                int64   `json:"dividendDate"`
+
+	PreMarketPrice          float64 `json:"preMarketPrice"`
+	PreMarketChange         float64 `json:"preMarketChange"`
+	PreMarketChangePercent  float64 `json:"preMarketChangePercent"`
+	PreMarketTime           int64   `json:"preMarketTime"`
+	PostMarketPrice         float64 `json:"postMarketPrice"`
+	PostMarketChange        float64 `json:"postMarketChange"`
+	PostMarketChangePercent float64 `json:"postMarketChangePercent"`
+	PostMarketTime          int64   `json:"postMarketTime"`
+
+	FirstTradeDateMilliseconds int64 `json:"firstTradeDateMilliseconds"`
+}
+
+// QuoteResponse QuoteResponse
+type QuoteResponse struct {
+	Result []QuoteResult `json:"result"`
+	Error  ErrorHistory  `json:"error"`
+}
+
+// QuoteInfomation QuoteInfomation
+type QuoteInfomation struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+	QuoteResponse  QuoteResponse `json:"quoteResponse"`
+}
+
+// ===============================================================================================================
+
+// Value formatted number of quoteSummary, Raw is null if Yahoo sends {}
+type Value struct {
+	Raw     NullFloat64 `json:"raw"`
+	Fmt     string      `json:"fmt"`
+	LongFmt string      `json:"longFmt"`
+}
+
+// Time converts the raw epoch of a date value
+func (v Value) Time() time.Time {
+	return time.Unix(int64(v.Raw.Float64), 0).UTC()
+}
+
+// CompanyOfficer CompanyOfficer
+type CompanyOfficer struct {
+	Name             string `json:"name"`
+	Age              int    `json:"age"`
+	Title            string `json:"title"`
+	YearBorn         int    `json:"yearBorn"`
+	FiscalYear       int    `json:"fiscalYear"`
+	TotalPay         Value  `json:"totalPay"`
+	ExercisedValue   Value  `json:"exercisedValue"`
+	UnexercisedValue Value  `json:"unexercisedValue"`
+}
+
+// AssetProfileModule assetProfile
+type AssetProfileModule struct {
+	Address1            string           `json:"address1"`
+	City                string           `json:"city"`
+	State               string           `json:"state"`
+	Zip                 string           `json:"zip"`
+	Country             string           `json:"country"`
+	Phone               string           `json:"phone"`
+	Website             string           `json:"website"`
+	Industry            string           `json:"industry"`
+	Sector              string           `json:"sector"`
+	LongBusinessSummary string           `json:"longBusinessSummary"`
+	FullTimeEmployees   int64            `json:"fullTimeEmployees"`
+	CompanyOfficers     []CompanyOfficer `json:"companyOfficers"`
+	MaxAge              int              `json:"maxAge"`
+}
+
+// SummaryDetailModule summaryDetail
+type SummaryDetailModule struct {
+	PreviousClose                Value  `json:"previousClose"`
+	Open                         Value  `json:"open"`
+	DayLow                       Value  `json:"dayLow"`
+	DayHigh                      Value  `json:"dayHigh"`
+	DividendRate                 Value  `json:"dividendRate"`
+	DividendYield                Value  `json:"dividendYield"`
+	ExDividendDate               Value  `json:"exDividendDate"`
+	PayoutRatio                  Value  `json:"payoutRatio"`
+	FiveYearAvgDividendYield     Value  `json:"fiveYearAvgDividendYield"`
+	TrailingAnnualDividendRate   Value  `json:"trailingAnnualDividendRate"`
+	TrailingAnnualDividendYield  Value  `json:"trailingAnnualDividendYield"`
+	Beta                         Value  `json:"beta"`
+	TrailingPE                   Value  `json:"trailingPE"`
+	ForwardPE                    Value  `json:"forwardPE"`
+	Volume                       Value  `json:"volume"`
+	AverageVolume                Value  `json:"averageVolume"`
+	AverageVolume10days          Value  `json:"averageVolume10days"`
+	Bid                          Value  `json:"bid"`
+	Ask                          Value  `json:"ask"`
+	BidSize                      Value  `json:"bidSize"`
+	AskSize                      Value  `json:"askSize"`
+	MarketCap                    Value  `json:"marketCap"`
+	FiftyTwoWeekLow              Value  `json:"fiftyTwoWeekLow"`
+	FiftyTwoWeekHigh             Value  `json:"fiftyTwoWeekHigh"`
+	PriceToSalesTrailing12Months Value  `json:"priceToSalesTrailing12Months"`
+	FiftyDayAverage              Value  `json:"fiftyDayAverage"`
+	TwoHundredDayAverage         Value  `json:"twoHundredDayAverage"`
+	Currency                     string `json:"currency"`
+	MaxAge                       int    `json:"maxAge"`
+}
+
+// FinancialDataModule financialData
+type FinancialDataModule struct {
+	CurrentPrice            Value  `json:"currentPrice"`
+	TargetHighPrice         Value  `json:"targetHighPrice"`
+	TargetLowPrice          Value  `json:"targetLowPrice"`
+	TargetMeanPrice         Value  `json:"targetMeanPrice"`
+	TargetMedianPrice       Value  `json:"targetMedianPrice"`
+	RecommendationMean      Value  `json:"recommendationMean"`
+	RecommendationKey       string `json:"recommendationKey"`
+	NumberOfAnalystOpinions Value  `json:"numberOfAnalystOpinions"`
+	TotalCash               Value  `json:"totalCash"`
+	TotalCashPerShare       Value  `json:"totalCashPerShare"`
+	Ebitda                  Value  `json:"ebitda"`
+	TotalDebt               Value  `json:"totalDebt"`
+	QuickRatio              Value  `json:"quickRatio"`
+	CurrentRatio            Value  `json:"currentRatio"`
+	TotalRevenue            Value  `json:"totalRevenue"`
+	DebtToEquity            Value  `json:"debtToEquity"`
+	RevenuePerShare         Value  `json:"revenuePerShare"`
+	ReturnOnAssets          Value  `json:"returnOnAssets"`
+	ReturnOnEquity          Value  `json:"returnOnEquity"`
+	GrossProfits            Value  `json:"grossProfits"`
+	FreeCashflow            Value  `json:"freeCashflow"`
+	OperatingCashflow       Value  `json:"operatingCashflow"`
+	EarningsGrowth          Value  `json:"earningsGrowth"`
+	RevenueGrowth           Value  `json:"revenueGrowth"`
+	GrossMargins            Value  `json:"grossMargins"`
+	EbitdaMargins           Value  `json:"ebitdaMargins"`
+	OperatingMargins        Value  `json:"operatingMargins"`
+	ProfitMargins           Value  `json:"profitMargins"`
+	FinancialCurrency       string `json:"financialCurrency"`
+	MaxAge                  int    `json:"maxAge"`
+}
+
+// DefaultKeyStatisticsModule defaultKeyStatistics
+type DefaultKeyStatisticsModule struct {
+	EnterpriseValue         Value  `json:"enterpriseValue"`
+	ForwardPE               Value  `json:"forwardPE"`
+	ProfitMargins           Value  `json:"profitMargins"`
+	FloatShares             Value  `json:"floatShares"`
+	SharesOutstanding       Value  `json:"sharesOutstanding"`
+	SharesShort             Value  `json:"sharesShort"`
+	SharesShortPriorMonth   Value  `json:"sharesShortPriorMonth"`
+	ShortRatio              Value  `json:"shortRatio"`
+	ShortPercentOfFloat     Value  `json:"shortPercentOfFloat"`
+	HeldPercentInsiders     Value  `json:"heldPercentInsiders"`
+	HeldPercentInstitutions Value  `json:"heldPercentInstitutions"`
+	Beta                    Value  `json:"beta"`
+	BookValue               Value  `json:"bookValue"`
+	PriceToBook             Value  `json:"priceToBook"`
+	LastFiscalYearEnd       Value  `json:"lastFiscalYearEnd"`
+	NextFiscalYearEnd       Value  `json:"nextFiscalYearEnd"`
+	MostRecentQuarter       Value  `json:"mostRecentQuarter"`
+	EarningsQuarterlyGrowth Value  `json:"earningsQuarterlyGrowth"`
+	NetIncomeToCommon       Value  `json:"netIncomeToCommon"`
+	TrailingEps             Value  `json:"trailingEps"`
+	ForwardEps              Value  `json:"forwardEps"`
+	PegRatio                Value  `json:"pegRatio"`
+	LastSplitFactor         string `json:"lastSplitFactor"`
+	LastSplitDate           Value  `json:"lastSplitDate"`
+	EnterpriseToRevenue     Value  `json:"enterpriseToRevenue"`
+	EnterpriseToEbitda      Value  `json:"enterpriseToEbitda"`
+	FiftyTwoWeekChange      Value  `json:"52WeekChange"`
+	SandP52WeekChange       Value  `json:"SandP52WeekChange"`
+	LastDividendValue       Value  `json:"lastDividendValue"`
+	LastDividendDate        Value  `json:"lastDividendDate"`
+	MaxAge                  int    `json:"maxAge"`
+}
+
+// PriceModule price
+type PriceModule struct {
+	Symbol                     string `json:"symbol"`
+	ShortName                  string `json:"shortName"`
+	LongName                   string `json:"longName"`
+	Currency                   string `json:"currency"`
+	QuoteType                  string `json:"quoteType"`
+	Exchange                   string `json:"exchange"`
+	ExchangeName               string `json:"exchangeName"`
+	MarketState                string `json:"marketState"`
+	RegularMarketPrice         Value  `json:"regularMarketPrice"`
+	RegularMarketChange        Value  `json:"regularMarketChange"`
+	RegularMarketChangePercent Value  `json:"regularMarketChangePercent"`
+	RegularMarketTime          int64  `json:"regularMarketTime"`
+	RegularMarketOpen          Value  `json:"regularMarketOpen"`
+	RegularMarketDayHigh       Value  `json:"regularMarketDayHigh"`
+	RegularMarketDayLow        Value  `json:"regularMarketDayLow"`
+	RegularMarketVolume        Value  `json:"regularMarketVolume"`
+	RegularMarketPreviousClose Value  `json:"regularMarketPreviousClose"`
+	PreMarketPrice             Value  `json:"preMarketPrice"`
+	PostMarketPrice            Value  `json:"postMarketPrice"`
+	MarketCap                  Value  `json:"marketCap"`
+	MaxAge                     int    `json:"maxAge"`
+}
+
+// LineItems line items of a fiscal period, e.g. totalRevenue
+type LineItems map[string]NullFloat64
+
+// Statement line items keyed by fiscal period end date (YYYY-MM-DD)
+type Statement map[string]LineItems
+
+// Dates sorted fiscal period end dates
+func (s Statement) Dates() []string {
+	dates := make([]string, 0, len(s))
+	for date := range s {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	return dates
+}
+
+// StatementModule incomeStatementHistory, balanceSheetHistory or cashflowStatementHistory
+// and their quarterly versions
+type StatementModule struct {
+	Statement Statement
+	MaxAge    int
+}
+
+// UnmarshalJSON decodes the statements list, whatever its key is
+// e.g. incomeStatementHistory, balanceSheetStatements or cashflowStatements
+func (m *StatementModule) UnmarshalJSON(b []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	m.Statement = Statement{}
+	for key, field := range fields {
+		if key == "maxAge" {
+			if err := json.Unmarshal(field, &m.MaxAge); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var periods []map[string]json.RawMessage
+		if err := json.Unmarshal(field, &periods); err != nil {
+			return err
+		}
+		for _, period := range periods {
+			var endDate Value
+			if err := json.Unmarshal(period["endDate"], &endDate); err != nil {
+				return err
+			}
+
+			items := LineItems{}
+			for name, item := range period {
+				if name == "endDate" || name == "maxAge" {
+					continue
+				}
+				var v Value
+				if err := json.Unmarshal(item, &v); err != nil {
+					return errors.Wrapf(err, "%s of %s", name, endDate.Time().Format("2006-01-02"))
+				}
+				items[name] = v.Raw
+			}
+			m.Statement[endDate.Time().Format("2006-01-02")] = items
+		}
+	}
+
+	return nil
+}
+
+// SummaryResult modules of a symbol, nil if not requested
+type SummaryResult struct {
+	AssetProfile         *AssetProfileModule         `json:"assetProfile"`
+	SummaryDetail        *SummaryDetailModule        `json:"summaryDetail"`
+	FinancialData        *FinancialDataModule        `json:"financialData"`
+	DefaultKeyStatistics *DefaultKeyStatisticsModule `json:"defaultKeyStatistics"`
+	Price                *PriceModule                `json:"price"`
+
+	IncomeStatementHistory            *StatementModule `json:"incomeStatementHistory"`
+	IncomeStatementHistoryQuarterly   *StatementModule `json:"incomeStatementHistoryQuarterly"`
+	BalanceSheetHistory               *StatementModule `json:"balanceSheetHistory"`
+	BalanceSheetHistoryQuarterly      *StatementModule `json:"balanceSheetHistoryQuarterly"`
+	CashflowStatementHistory          *StatementModule `json:"cashflowStatementHistory"`
+	CashflowStatementHistoryQuarterly *StatementModule `json:"cashflowStatementHistoryQuarterly"`
+}
+
+// QuoteSummary QuoteSummary
+type QuoteSummary struct {
+	Result []SummaryResult `json:"result"`
+	Error  ErrorHistory    `json:"error"`
+}
+
+// SummaryInfomation SummaryInfomation
+type SummaryInfomation struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+	QuoteSummary   QuoteSummary `json:"quoteSummary"`
+}
+
+// ===============================================================================================================
+
+// TimeseriesMeta TimeseriesMeta
+type TimeseriesMeta struct {
+	Symbol []string `json:"symbol"`
+	Type   []string `json:"type"`
+}
+
+// TimeseriesValue reported value of a line item
+type TimeseriesValue struct {
+	DataID        int    `json:"dataId"`
+	AsOfDate      string `json:"asOfDate"`
+	PeriodType    string `json:"periodType"`
+	CurrencyCode  string `json:"currencyCode"`
+	ReportedValue Value  `json:"reportedValue"`
+}
+
+// TimeseriesResult history of a line item
+type TimeseriesResult struct {
+	Meta      TimeseriesMeta `json:"meta"`
+	Timestamp []int64        `json:"timestamp"`
+	// Values is keyed by the line item type in the response, null values are skipped
+	Values []TimeseriesValue `json:"-"`
+}
+
+// UnmarshalJSON decodes Values from the key named by Meta.Type
+func (r *TimeseriesResult) UnmarshalJSON(b []byte) error {
+	type result TimeseriesResult
+	if err := json.Unmarshal(b, (*result)(r)); err != nil {
+		return err
+	}
+	if len(r.Meta.Type) == 0 {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+	field, ok := fields[r.Meta.Type[0]]
+	if !ok {
+		return nil
+	}
+	var values []*TimeseriesValue
+	if err := json.Unmarshal(field, &values); err != nil {
+		return err
+	}
+	r.Values = make([]TimeseriesValue, 0, len(values))
+	for _, v := range values {
+		if v != nil {
+			r.Values = append(r.Values, *v)
+		}
+	}
+
+	return nil
+}
+
+// Timeseries Timeseries
+type Timeseries struct {
+	Result []TimeseriesResult `json:"result"`
+	Error  ErrorHistory       `json:"error"`
+}
+
+// TimeseriesInfomation TimeseriesInfomation
+type TimeseriesInfomation struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+	Timeseries     Timeseries `json:"timeseries"`
+}
+
+// Statement pivots line items by AsOfDate
+func (info *TimeseriesInfomation) Statement() Statement {
+	s := Statement{}
+	for _, r := range info.Timeseries.Result {
+		if len(r.Meta.Type) == 0 {
+			continue
+		}
+		for _, v := range r.Values {
+			if _, ok := s[v.AsOfDate]; !ok {
+				s[v.AsOfDate] = LineItems{}
+			}
+			s[v.AsOfDate][r.Meta.Type[0]] = v.ReportedValue.Raw
+		}
+	}
+
+	return s
+}
+
+// ===============================================================================================================
+
+// OptionContract call or put
+type OptionContract struct {
+	ContractSymbol    string  `json:"contractSymbol"`
+	Strike            float64 `json:"strike"`
+	Currency          string  `json:"currency"`
+	LastPrice         float64 `json:"lastPrice"`
+	Change            float64 `json:"change"`
+	PercentChange     float64 `json:"percentChange"`
+	Volume            int64   `json:"volume"`
+	OpenInterest      int64   `json:"openInterest"`
+	Bid               float64 `json:"bid"`
+	Ask               float64 `json:"ask"`
+	ContractSize      string  `json:"contractSize"`
+	Expiration        int64   `json:"expiration"`
+	LastTradeDate     int64   `json:"lastTradeDate"`
+	ImpliedVolatility float64 `json:"impliedVolatility"`
+	InTheMoney        bool    `json:"inTheMoney"`
+}
+
+// ExpirationOptions contracts of an expiration
+type ExpirationOptions struct {
+	ExpirationDate int64            `json:"expirationDate"`
+	HasMiniOptions bool             `json:"hasMiniOptions"`
+	Calls          []OptionContract `json:"calls"`
+	Puts           []OptionContract `json:"puts"`
+}
+
+// OptionChainResult OptionChainResult
+type OptionChainResult struct {
+	UnderlyingSymbol string              `json:"underlyingSymbol"`
+	ExpirationDates  []int64             `json:"expirationDates"`
+	Strikes          []float64           `json:"strikes"`
+	HasMiniOptions   bool                `json:"hasMiniOptions"`
+	Quote            QuoteResult         `json:"quote"`
+	Options          []ExpirationOptions `json:"options"`
+}
+
+// OptionChain OptionChain
+type OptionChain struct {
+	Result []OptionChainResult `json:"result"`
+	Error  ErrorHistory        `json:"error"`
+}
+
+// OptionsInfomation OptionsInfomation
+type OptionsInfomation struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+	OptionChain    OptionChain `json:"optionChain"`
+}
+
+// ===============================================================================================================
+
+// SearchQuote symbol hit
+type SearchQuote struct {
+	Symbol         string  `json:"symbol"`
+	ShortName      string  `json:"shortname"`
+	LongName       string  `json:"longname"`
+	Exchange       string  `json:"exchange"`
+	ExchDisp       string  `json:"exchDisp"`
+	QuoteType      string  `json:"quoteType"`
+	TypeDisp       string  `json:"typeDisp"`
+	Index          string  `json:"index"`
+	Score          float64 `json:"score"`
+	IsYahooFinance bool    `json:"isYahooFinance"`
+}
+
+// SearchNews news hit
+type SearchNews struct {
+	UUID                string   `json:"uuid"`
+	Title               string   `json:"title"`
+	Publisher           string   `json:"publisher"`
+	Link                string   `json:"link"`
+	ProviderPublishTime int64    `json:"providerPublishTime"`
+	Type                string   `json:"type"`
+	RelatedTickers      []string `json:"relatedTickers"`
+}
+
+// SearchInfomation SearchInfomation
+type SearchInfomation struct {
+	// ServerResponse contains the HTTP response code and headers from the
+	// server.
+	ServerResponse `json:"-"`
+	Count          int           `json:"count"`
+	Quotes         []SearchQuote `json:"quotes"`
+	News           []SearchNews  `json:"news"`
+}
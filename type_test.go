@@ -0,0 +1,109 @@
+package yahoofinance
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// nullFloats converts v to NullFloat64, NaN is null
+func nullFloats(v ...float64) []NullFloat64 {
+	ns := make([]NullFloat64, len(v))
+	for i, f := range v {
+		if !math.IsNaN(f) {
+			ns[i] = NullFloat64{Float64: f, Valid: true}
+		}
+	}
+	return ns
+}
+
+// testResult bars of close at timestamps, NaN is null: open is close, high and low
+// are spread above and below it, volume is 100 times close and adjclose is close
+func testResult(meta Meta, timestamps []int64, spread float64, close ...float64) *Result {
+	shift := func(d float64) []NullFloat64 {
+		v := make([]float64, len(close))
+		for i, c := range close {
+			v[i] = c + d
+		}
+		return nullFloats(v...)
+	}
+	volume := make([]float64, len(close))
+	for i, c := range close {
+		volume[i] = math.Floor(c * 100)
+	}
+
+	return &Result{
+		Meta:      meta,
+		Timestamp: timestamps,
+		Indicators: Indicators{
+			Quote: []Quote{{
+				Volume: nullFloats(volume...),
+				Close:  nullFloats(close...),
+				Open:   nullFloats(close...),
+				High:   shift(spread),
+				Low:    shift(-spread),
+			}},
+			Adjclose: []Adjclose{{nullFloats(close...)}},
+		},
+	}
+}
+
+func TestQuote_UnmarshalJSON(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		name    string
+		data    string
+		want    Quote
+		wantErr bool
+	}{
+		// TODO: Add test cases.
+		{"Test", `{"volume":[100,null,0],"close":[1.5,null,2],"open":[1,null,2],"high":[2,null,2],"low":[0.5,null,2]}`, Quote{
+			Volume: nullFloats(100, nan, 0),
+			Close:  nullFloats(1.5, nan, 2),
+			Open:   nullFloats(1, nan, 2),
+			High:   nullFloats(2, nan, 2),
+			Low:    nullFloats(0.5, nan, 2),
+		}, false},
+		{"Invalid", `{"volume":["a"]}`, Quote{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Quote
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("json.Unmarshal() = \n%+v, want \n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullFloat64_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		v    []NullFloat64
+		want string
+	}{
+		// TODO: Add test cases.
+		{"Test", nullFloats(1.5, math.NaN(), 0), "[1.5,null,0]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
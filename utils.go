@@ -47,18 +47,25 @@ func CheckResponse(res *http.Response) error {
 		return errors.Wrapf(err, "ioutil.ReadAll")
 	}
 
+	apiErr := &Error{
+		Code:   res.StatusCode,
+		Body:   string(b),
+		Header: res.Header,
+	}
+	// non-JSON body, e.g. an HTML error page, is kept in Body
 	errReply := &errorReply{}
-	err = json.Unmarshal(b, errReply)
-	if err != nil {
-		return nil
+	if err := json.Unmarshal(b, errReply); err != nil {
+		return apiErr
 	}
-
-	return &Error{
-		Code:    res.StatusCode,
-		Message: fmt.Sprintf("API: code %s with description: %s", errReply.Chart.Error.Code, errReply.Chart.Error.Description),
-		Body:    string(b),
-		Header:  res.Header,
+	e := errReply.error()
+	if e.Code == "" && e.Description == "" {
+		return apiErr
 	}
+	apiErr.YahooCode = e.Code
+	apiErr.Description = e.Description
+	apiErr.Message = fmt.Sprintf("API: code %s with description: %s", e.Code, e.Description)
+
+	return apiErr
 }
 
 // DecodeResponse decodes the body of res into target. If there is no body,
@@ -122,11 +129,11 @@ func ResolveRelative(basePath string, elem ...string) string {
 	return u.String()
 }
 
-// parseDate parses a date value from a string.
+// parseDate parses a date value from a string in loc.
 // An error is returned if the value is not in one of the dateFormat formats.
-func parseDate(v string, dateFormat ...string) (time.Time, error) {
+func parseDate(v string, loc *time.Location, dateFormat ...string) (time.Time, error) {
 	for _, format := range dateFormat {
-		t, err := time.Parse(format, v)
+		t, err := time.ParseInLocation(format, v, loc)
 		if err != nil {
 			continue
 		}
@@ -1,6 +1,11 @@
 package yahoofinance
 
-import "testing"
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
 
 func TestResolveRelative(t *testing.T) {
 	type args struct {
@@ -23,3 +28,40 @@ func TestResolveRelative(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		statusCode int
+		want       string
+		wantErr    bool
+	}{
+		// TODO: Add test cases.
+		{"OK", `{}`, http.StatusOK, "", false},
+		{"Chart", `{"chart":{"result":null,"error":{"code":"Not Found","description":"No data found, symbol may be delisted"}}}`, http.StatusNotFound,
+			"API: Error 404: API: code Not Found with description: No data found, symbol may be delisted", true},
+		{"Finance", `{"finance":{"result":null,"error":{"code":"Unauthorized","description":"Invalid Crumb"}}}`, http.StatusUnauthorized,
+			"API: Error 401: API: code Unauthorized with description: Invalid Crumb", true},
+		{"HTML", `<html><body>Service Unavailable</body></html>`, http.StatusServiceUnavailable,
+			"API: got HTTP response code 503 with body: <html><body>Service Unavailable</body></html>", true},
+		{"EmptyJSON", `{}`, http.StatusBadGateway, "API: got HTTP response code 502 with body: {}", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{
+				StatusCode: tt.statusCode,
+				Body:       ioutil.NopCloser(strings.NewReader(tt.body)),
+				Header:     http.Header{},
+			}
+			err := CheckResponse(res)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckResponse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && err.Error() != tt.want {
+				t.Errorf("CheckResponse() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
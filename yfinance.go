@@ -1,12 +1,13 @@
 package yahoofinance
 
 import (
-	"errors"
 	"log"
 	"net"
 	"net/http"
 	"net/http/cookiejar"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 // const strings
@@ -15,16 +16,38 @@ const (
 	userAgent = `Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:62.0) Gecko/20100101 Firefox/62.0`
 
 	HOST = "https://query1.finance.yahoo.com/"
+
+	// acceptLanguage is the default Accept-Language header
+	acceptLanguage = "zh-TW,zh;q=0.9,en-US;q=0.8,en;q=0.7"
 )
 
 // Service Yahoo Finance api
 type Service struct {
 	client *http.Client
 
-	host string // API endpoint base URL
+	host  string    // API endpoint base URL
+	hosts *hostPool // failover hosts, nil uses host only
+
+	agent    string
+	language string // Accept-Language header
+	lang     string // lang query parameter, e.g. en-US
+	region   string // region query parameter, e.g. US
+
+	limiter RateLimiter
+	retry   *RetryPolicy
+	auth    Authenticator
+	cache   Cache
+	store   Store
+
+	middlewares []Middleware
+
+	err error // first error of options
 
 	History *HistoryService
 	Quote *QuoteService
+	Summary *SummaryService
+	Options *OptionsService
+	Search *SearchService
 }
 
 // GetClient get client
@@ -48,18 +71,137 @@ func GetClient() *http.Client {
 	return client
 }
 
+// Option configures Service
+type Option func(*Service)
+
+// WithHost API endpoint base URL, Default is HOST
+// e.g. https://query2.finance.yahoo.com/ or a local mock server
+func WithHost(host string) Option {
+	return func(s *Service) {
+		s.host = host
+		s.hosts = nil
+	}
+}
+
+// WithHosts same as SetHosts
+func WithHosts(hosts ...string) Option {
+	return func(s *Service) {
+		if err := s.SetHosts(hosts...); err != nil && s.err == nil {
+			s.err = err
+		}
+	}
+}
+
+// WithUserAgent User-Agent header, Default is Firefox
+func WithUserAgent(agent string) Option {
+	return func(s *Service) {
+		s.agent = agent
+	}
+}
+
+// WithLanguage Accept-Language header and lang parameter, e.g. en-US
+// Default is Traditional Chinese without lang parameter
+func WithLanguage(lang string) Option {
+	return func(s *Service) {
+		s.language = lang
+		s.lang = lang
+	}
+}
+
+// WithRegion region parameter, e.g. US
+func WithRegion(region string) Option {
+	return func(s *Service) {
+		s.region = region
+	}
+}
+
+// WithHTTPClient replaces the client passed to New
+func WithHTTPClient(client *http.Client) Option {
+	return func(s *Service) {
+		s.client = client
+	}
+}
+
+// WithRateLimiter same as SetRateLimiter
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(s *Service) {
+		s.SetRateLimiter(limiter)
+	}
+}
+
+// WithRetryPolicy same as SetRetryPolicy
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(s *Service) {
+		s.SetRetryPolicy(policy)
+	}
+}
+
+// WithAuthenticator same as SetAuthenticator
+func WithAuthenticator(auth Authenticator) Option {
+	return func(s *Service) {
+		s.SetAuthenticator(auth)
+	}
+}
+
+// WithCache same as SetCache
+func WithCache(store Cache) Option {
+	return func(s *Service) {
+		s.SetCache(store)
+	}
+}
+
+// WithStore same as SetStore
+func WithStore(store Store) Option {
+	return func(s *Service) {
+		s.SetStore(store)
+	}
+}
+
+// WithMiddleware same as Use
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(s *Service) {
+		s.Use(middlewares...)
+	}
+}
+
 // New Yahoo Finance API server
-func New(client *http.Client) (*Service, error) {
-	if client == nil {
+// client may be nil if WithHTTPClient is given
+func New(client *http.Client, opts ...Option) (*Service, error) {
+	s := &Service{client: client, host: HOST, agent: userAgent, language: acceptLanguage}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.err != nil {
+		return nil, errors.Wrapf(s.err, "option")
+	}
+	if s.client == nil {
 		return nil, errors.New("client is nil")
 	}
-	s := &Service{client: client, host: HOST}
 	s.History = NewHistoryService(s)
 	s.Quote = NewQuoteService(s)
+	s.Summary = NewSummaryService(s)
+	s.Options = NewOptionsService(s)
+	s.Search = NewSearchService(s)
 
 	return s, nil
 }
 
 func (s *Service) userAgent() string {
-	return userAgent
+	return s.agent
+}
+
+// SetRateLimiter every request waits for limiter before sending, nil disables limiting
+func (s *Service) SetRateLimiter(limiter RateLimiter) {
+	s.limiter = limiter
+}
+
+// SetRetryPolicy retry transient failures of every request, nil disables retry
+// Calls can override it by Retry
+func (s *Service) SetRetryPolicy(policy *RetryPolicy) {
+	s.retry = policy
+}
+
+// SetAuthenticator authorize every request by auth, nil disables authorization
+func (s *Service) SetAuthenticator(auth Authenticator) {
+	s.auth = auth
 }
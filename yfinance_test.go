@@ -2,6 +2,7 @@ package yahoofinance
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 )
 
@@ -27,6 +28,64 @@ func TestNewServer(t *testing.T) {
 	}
 }
 
+func TestNew_Options(t *testing.T) {
+	client := clientTest(`{"chart":{"result":[{"meta":{"symbol":"VTI"}}],"error":null}}`, http.StatusOK)
+
+	tests := []struct {
+		name         string
+		client       *http.Client
+		opts         []Option
+		wantURL      string
+		wantAgent    string
+		wantLanguage string
+		wantErr      bool
+	}{
+		// TODO: Add test cases.
+		{"Default", client, nil,
+			"https://query1.finance.yahoo.com/v8/finance/chart/VTI?events=div%2Csplits&includeAdjustedClose=true&interval=1d&range=1mo",
+			userAgent, acceptLanguage, false},
+		{"Options", nil, []Option{
+			WithHTTPClient(client),
+			WithHost("https://query2.finance.yahoo.com/"),
+			WithUserAgent("test"),
+			WithLanguage("en-US"),
+			WithRegion("US"),
+		},
+			"https://query2.finance.yahoo.com/v8/finance/chart/VTI?events=div%2Csplits&includeAdjustedClose=true&interval=1d&lang=en-US&range=1mo&region=US",
+			"test", "en-US", false},
+		{"NilClient", nil, nil, "", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			opts := append(tt.opts, WithMiddleware(BeforeSend(func(r *http.Request) error {
+				req = r
+				return nil
+			})))
+			yfinance, err := New(tt.client, opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if _, err := yfinance.History.Period("VTI", "1mo", "1d").Do(); err != nil {
+				t.Fatal(err)
+			}
+			if got := req.URL.String(); got != tt.wantURL {
+				t.Errorf("URL = %v, want %v", got, tt.wantURL)
+			}
+			if got := req.Header.Get("User-Agent"); got != tt.wantAgent {
+				t.Errorf("User-Agent = %v, want %v", got, tt.wantAgent)
+			}
+			if got := req.Header.Get("Accept-Language"); got != tt.wantLanguage {
+				t.Errorf("Accept-Language = %v, want %v", got, tt.wantLanguage)
+			}
+		})
+	}
+}
+
 func ExampleHistoryService_Period() {
 	client := GetClient()
 	yfinance, err := New(client)